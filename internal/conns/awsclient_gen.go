@@ -226,6 +226,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pinpointemail"
 	"github.com/aws/aws-sdk-go/service/pinpointsmsvoice"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
 	"github.com/aws/aws-sdk-go/service/polly"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
@@ -322,6 +323,9 @@ type AWSClient struct {
 	SupportedPlatforms        []string
 	TerraformVersion          string
 
+	endpoints     map[string]string
+	regionalConns *regionalConnCache
+
 	ACMConn                          *acm.ACM
 	ACMPCAConn                       *acmpca.ACMPCA
 	AMPConn                          *prometheusservice.PrometheusService
@@ -539,6 +543,7 @@ type AWSClient struct {
 	PinpointConn                     *pinpoint.Pinpoint
 	PinpointEmailConn                *pinpointemail.PinpointEmail
 	PinpointSMSVoiceConn             *pinpointsmsvoice.PinpointSMSVoice
+	PinpointSMSVoiceV2Conn           *pinpointsmsvoicev2.PinpointSMSVoiceV2
 	PollyConn                        *polly.Polly
 	PricingConn                      *pricing.Pricing
 	ProtonConn                       *proton.Proton
@@ -183,9 +183,11 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
+	client.endpoints = c.Endpoints
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.regionalConns = newRegionalConnCache()
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
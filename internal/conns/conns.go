@@ -5,27 +5,11 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
-	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
-func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*session.Session, error) {
-	session, err := session.NewSession(cfg)
-
-	if err != nil {
-		return nil, err
-	}
-
-	apnInfo := StdUserAgentProducts(terraformVersion)
-
-	awsbasev1.SetSessionUserAgent(session, apnInfo, awsbase.UserAgentProducts{})
-
-	return session.Copy(&aws.Config{Region: aws.String(region)}), nil
-}
-
 func StdUserAgentProducts(terraformVersion string) *awsbase.APNInfo {
 	return &awsbase.APNInfo{
 		PartnerName: "HashiCorp",
@@ -0,0 +1,82 @@
+package conns
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionalConnFactories contains the client constructors for services whose
+// resources must make calls in a Region other than the provider's.
+var regionalConnFactories = map[string]func(*session.Session) interface{}{
+	names.ACM:       func(sess *session.Session) interface{} { return acm.New(sess) },
+	names.EFS:       func(sess *session.Session) interface{} { return efs.New(sess) },
+	names.KMS:       func(sess *session.Session) interface{} { return kms.New(sess) },
+	names.OpsWorks:  func(sess *session.Session) interface{} { return opsworks.New(sess) },
+	names.RDS:       func(sess *session.Session) interface{} { return rds.New(sess) },
+	names.S3Control: func(sess *session.Session) interface{} { return s3control.New(sess) },
+}
+
+type regionalConnCache struct {
+	conns map[string]interface{}
+	mutex sync.Mutex
+}
+
+func newRegionalConnCache() *regionalConnCache {
+	return &regionalConnCache{
+		conns: make(map[string]interface{}),
+	}
+}
+
+// RegionalConn returns an API client for the specified service that makes calls in the specified Region.
+// The client is derived from the provider's session, so credentials, FIPS and dual-stack settings
+// and any custom endpoint configured for the service are preserved.
+// Clients are cached per service and Region. The caller type asserts the result to the service's client type,
+// e.g. conn.(*kms.KMS).
+func (client *AWSClient) RegionalConn(service, region string) (interface{}, error) {
+	factory, ok := regionalConnFactories[service]
+
+	if !ok {
+		return nil, fmt.Errorf("regional API clients are not supported for service (%s)", service)
+	}
+
+	if client.Session == nil {
+		return nil, fmt.Errorf("creating %s API client for Region (%s): AWS session not configured", service, region)
+	}
+
+	newConn := func() interface{} {
+		return factory(client.Session.Copy(&aws.Config{
+			Endpoint: aws.String(client.endpoints[service]),
+			Region:   aws.String(region),
+		}))
+	}
+
+	cache := client.regionalConns
+
+	if cache == nil {
+		return newConn(), nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	key := service + "/" + region
+
+	if conn, ok := cache.conns[key]; ok {
+		return conn, nil
+	}
+
+	conn := newConn()
+	cache.conns[key] = conn
+
+	return conn, nil
+}
@@ -0,0 +1,76 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAWSClientRegionalConn(t *testing.T) { // nosemgrep:aws-in-func-name
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:     credentials.AnonymousCredentials,
+		Region:          aws.String(endpoints.UsWest2RegionID),
+		UseFIPSEndpoint: endpoints.FIPSEndpointStateEnabled,
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &AWSClient{
+		Region:        endpoints.UsWest2RegionID,
+		Session:       sess,
+		endpoints:     map[string]string{names.RDS: "https://rds.example.com"},
+		regionalConns: newRegionalConnCache(),
+	}
+
+	raw, err := client.RegionalConn(names.KMS, endpoints.UsEast1RegionID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	kmsConn, ok := raw.(*kms.KMS)
+
+	if !ok {
+		t.Fatalf("got %T, expected *kms.KMS", raw)
+	}
+
+	if got, expected := aws.StringValue(kmsConn.Config.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got Region %s, expected %s", got, expected)
+	}
+
+	if got, expected := kmsConn.Endpoint, "https://kms-fips.us-east-1.amazonaws.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	cached, err := client.RegionalConn(names.KMS, endpoints.UsEast1RegionID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if cached != raw {
+		t.Error("expected cached client to be returned")
+	}
+
+	raw, err = client.RegionalConn(names.RDS, endpoints.EuWest1RegionID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := raw.(*rds.RDS).Endpoint, "https://rds.example.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if _, err := client.RegionalConn(names.EC2, endpoints.UsEast1RegionID); err == nil {
+		t.Error("expected error for unsupported service")
+	}
+}
@@ -149,6 +149,9 @@ type AWSClient struct {
 	SupportedPlatforms        []string
 	TerraformVersion          string
 
+	endpoints     map[string]string
+	regionalConns *regionalConnCache
+
 	{{ range .Services }}
 	{{ .ProviderNameUpper }}Conn *{{ .GoPackage }}.{{ .ClientName }}
 	{{- end }}
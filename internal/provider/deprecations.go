@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deprecatedResources maps deprecated resource type names to their replacements.
+var deprecatedResources = map[string]string{
+	"aws_elasticsearch_domain":              "aws_opensearch_domain",
+	"aws_elasticsearch_domain_policy":       "aws_opensearch_domain_policy",
+	"aws_elasticsearch_domain_saml_options": "aws_opensearch_domain_saml_options",
+	"aws_s3_bucket_object":                  "aws_s3_object",
+}
+
+// deprecatedDataSources maps deprecated data source type names to their replacements.
+var deprecatedDataSources = map[string]string{
+	"aws_elasticsearch_domain": "aws_opensearch_domain",
+	"aws_s3_bucket_object":     "aws_s3_object",
+	"aws_s3_bucket_objects":    "aws_s3_objects",
+	"aws_subnet_ids":           "aws_subnets",
+}
+
+// deprecateResources sets a deprecation message naming the replacement on each deprecated entry of m.
+// The SDK reports each message as a separate "Deprecated Resource" warning when the block is validated.
+// Terraform groups warnings with the same summary, showing the first detail followed by a count of the others.
+func deprecateResources(m map[string]*schema.Resource, deprecations map[string]string, kind string) {
+	for name, replacement := range deprecations {
+		if r, ok := m[name]; ok {
+			r.DeprecationMessage = fmt.Sprintf("The %[1]s %[2]s has been deprecated and will be removed in a future version. Use the %[3]s %[2]s instead.", name, kind, replacement)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeprecations(t *testing.T) {
+	p := Provider()
+
+	testCases := []struct {
+		kind         string
+		m            map[string]*schema.Resource
+		deprecations map[string]string
+	}{
+		{
+			kind:         "data source",
+			m:            p.DataSourcesMap,
+			deprecations: deprecatedDataSources,
+		},
+		{
+			kind:         "resource",
+			m:            p.ResourcesMap,
+			deprecations: deprecatedResources,
+		},
+	}
+
+	for _, testCase := range testCases {
+		for name, replacement := range testCase.deprecations {
+			r, ok := testCase.m[name]
+
+			if !ok {
+				t.Errorf("deprecated %s %s is not registered", testCase.kind, name)
+				continue
+			}
+
+			if !strings.Contains(r.DeprecationMessage, replacement) {
+				t.Errorf("deprecated %s %s: expected deprecation message to name %s, got %q", testCase.kind, name, replacement, r.DeprecationMessage)
+			}
+
+			v, ok := testCase.m[replacement]
+
+			if !ok {
+				t.Errorf("replacement %s %s for %s is not registered", testCase.kind, replacement, name)
+				continue
+			}
+
+			if v.DeprecationMessage != "" {
+				t.Errorf("replacement %s %s for %s is itself deprecated", testCase.kind, replacement, name)
+			}
+		}
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
@@ -96,12 +97,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -135,6 +140,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -142,6 +148,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
@@ -159,6 +167,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
@@ -169,13 +178,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/translate"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
@@ -443,6 +455,8 @@ func Provider() *schema.Provider {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_auditmanager_evidence_folders": auditmanager.DataSourceEvidenceFolders(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -480,6 +494,8 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail_service_account": cloudtrail.DataSourceServiceAccount(),
 
+			"aws_cloudwatch_dashboard_body": cloudwatch.DataSourceDashboardBody(),
+
 			"aws_cloudwatch_event_bus":        events.DataSourceBus(),
 			"aws_cloudwatch_event_connection": events.DataSourceConnection(),
 			"aws_cloudwatch_event_source":     events.DataSourceSource(),
@@ -559,6 +575,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
 			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
+			"aws_ec2_traffic_mirror_filters":                 ec2.DataSourceTrafficMirrorFilters(),
+			"aws_ec2_traffic_mirror_sessions":                ec2.DataSourceTrafficMirrorSessions(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
 			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
 			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
@@ -600,6 +618,7 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
 			"aws_vpc":                                        ec2.DataSourceVPC(),
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
+			"aws_vpn_connection_device_sample_configuration": ec2.DataSourceVPNConnectionDeviceSampleConfiguration(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
@@ -767,6 +786,7 @@ func Provider() *schema.Provider {
 
 			"aws_networkmanager_connection":                   networkmanager.DataSourceConnection(),
 			"aws_networkmanager_connections":                  networkmanager.DataSourceConnections(),
+			"aws_networkmanager_core_network_policy":          networkmanager.DataSourceCoreNetworkPolicy(),
 			"aws_networkmanager_core_network_policy_document": networkmanager.DataSourceCoreNetworkPolicyDocument(),
 			"aws_networkmanager_device":                       networkmanager.DataSourceDevice(),
 			"aws_networkmanager_devices":                      networkmanager.DataSourceDevices(),
@@ -824,6 +844,7 @@ func Provider() *schema.Provider {
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
+			"aws_route53_health_check_status":     route53.DataSourceHealthCheckStatus(),
 			"aws_route53_traffic_policy_document": route53.DataSourceTrafficPolicyDocument(),
 			"aws_route53_zone":                    route53.DataSourceZone(),
 
@@ -1000,6 +1021,7 @@ func Provider() *schema.Provider {
 			"aws_apprunner_service":                            apprunner.ResourceService(),
 
 			"aws_appstream_directory_config":        appstream.ResourceDirectoryConfig(),
+			"aws_appstream_entitlement":             appstream.ResourceEntitlement(),
 			"aws_appstream_fleet":                   appstream.ResourceFleet(),
 			"aws_appstream_fleet_stack_association": appstream.ResourceFleetStackAssociation(),
 			"aws_appstream_image_builder":           appstream.ResourceImageBuilder(),
@@ -1021,6 +1043,9 @@ func Provider() *schema.Provider {
 			"aws_athena_named_query":  athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
 
+			"aws_auditmanager_assessment_delegation": auditmanager.ResourceAssessmentDelegation(),
+			"aws_auditmanager_assessment_report":     auditmanager.ResourceAssessmentReport(),
+
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
 			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
@@ -1180,6 +1205,7 @@ func Provider() *schema.Provider {
 			"aws_connect_contact_flow":                connect.ResourceContactFlow(),
 			"aws_connect_contact_flow_module":         connect.ResourceContactFlowModule(),
 			"aws_connect_instance":                    connect.ResourceInstance(),
+			"aws_connect_instance_storage_config":     connect.ResourceInstanceStorageConfig(),
 			"aws_connect_hours_of_operation":          connect.ResourceHoursOfOperation(),
 			"aws_connect_lambda_function_association": connect.ResourceLambdaFunctionAssociation(),
 			"aws_connect_queue":                       connect.ResourceQueue(),
@@ -1219,9 +1245,11 @@ func Provider() *schema.Provider {
 			"aws_devicefarm_test_grid_project": devicefarm.ResourceTestGridProject(),
 			"aws_devicefarm_upload":            devicefarm.ResourceUpload(),
 
-			"aws_detective_graph":               detective.ResourceGraph(),
-			"aws_detective_invitation_accepter": detective.ResourceInvitationAccepter(),
-			"aws_detective_member":              detective.ResourceMember(),
+			"aws_detective_graph":                      detective.ResourceGraph(),
+			"aws_detective_invitation_accepter":        detective.ResourceInvitationAccepter(),
+			"aws_detective_member":                     detective.ResourceMember(),
+			"aws_detective_organization_admin_account": detective.ResourceOrganizationAdminAccount(),
+			"aws_detective_organization_configuration": detective.ResourceOrganizationConfiguration(),
 
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
 			"aws_dx_connection":                                directconnect.ResourceConnection(),
@@ -1300,6 +1328,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                    ec2.ResourceNetworkInsightsAnalysis(),
 			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
 			"aws_ec2_serial_console_access":                        ec2.ResourceSerialConsoleAccess(),
 			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
@@ -1364,6 +1393,7 @@ func Provider() *schema.Provider {
 			"aws_vpc_endpoint_security_group_association":          ec2.ResourceVPCEndpointSecurityGroupAssociation(),
 			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
 			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_service_private_dns_verification":    ec2.ResourceVPCEndpointServicePrivateDNSVerification(),
 			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
 			"aws_vpc_ipam":                                         ec2.ResourceIPAM(),
 			"aws_vpc_ipam_organization_admin_account":              ec2.ResourceIPAMOrganizationAdminAccount(),
@@ -1527,6 +1557,13 @@ func Provider() *schema.Provider {
 			"aws_grafana_workspace":                    grafana.ResourceWorkspace(),
 			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSAMLConfiguration(),
 
+			"aws_greengrassv2_component_version": greengrassv2.ResourceComponentVersion(),
+			"aws_greengrassv2_deployment":        greengrassv2.ResourceDeployment(),
+
+			"aws_groundstation_config":                  groundstation.ResourceConfig(),
+			"aws_groundstation_dataflow_endpoint_group": groundstation.ResourceDataflowEndpointGroup(),
+			"aws_groundstation_mission_profile":         groundstation.ResourceMissionProfile(),
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
@@ -1577,7 +1614,10 @@ func Provider() *schema.Provider {
 			"aws_inspector_resource_group":      inspector.ResourceResourceGroup(),
 
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
+			"aws_iot_billing_group":              iot.ResourceBillingGroup(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
+			"aws_iot_custom_metric":              iot.ResourceCustomMetric(),
+			"aws_iot_fleet_metric":               iot.ResourceFleetMetric(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
 			"aws_iot_logging_options":            iot.ResourceLoggingOptions(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
@@ -1592,6 +1632,13 @@ func Provider() *schema.Provider {
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 			"aws_iot_topic_rule_destination":     iot.ResourceTopicRuleDestination(),
 
+			"aws_iotsitewise_asset":       iotsitewise.ResourceAsset(),
+			"aws_iotsitewise_asset_model": iotsitewise.ResourceAssetModel(),
+
+			"aws_iottwinmaker_component_type": iottwinmaker.ResourceComponentType(),
+			"aws_iottwinmaker_scene":          iottwinmaker.ResourceScene(),
+			"aws_iottwinmaker_workspace":      iottwinmaker.ResourceWorkspace(),
+
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
@@ -1660,9 +1707,10 @@ func Provider() *schema.Provider {
 			"aws_lightsail_static_ip":                            lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":                 lightsail.ResourceStaticIPAttachment(),
 
-			"aws_location_map":         location.ResourceMap(),
-			"aws_location_place_index": location.ResourcePlaceIndex(),
-			"aws_location_tracker":     location.ResourceTracker(),
+			"aws_location_geofence_collection": location.ResourceGeofenceCollection(),
+			"aws_location_map":                 location.ResourceMap(),
+			"aws_location_place_index":         location.ResourcePlaceIndex(),
+			"aws_location_tracker":             location.ResourceTracker(),
 
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
@@ -1760,6 +1808,11 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_pinpointsmsvoicev2_configuration_set": pinpointsmsvoicev2.ResourceConfigurationSet(),
+			"aws_pinpointsmsvoicev2_opt_out_list":      pinpointsmsvoicev2.ResourceOptOutList(),
+			"aws_pinpointsmsvoicev2_phone_number":      pinpointsmsvoicev2.ResourcePhoneNumber(),
+			"aws_pinpointsmsvoicev2_pool":              pinpointsmsvoicev2.ResourcePool(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 			"aws_qldb_stream": qldb.ResourceStream(),
 
@@ -1796,26 +1849,35 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
 
-			"aws_redshift_authentication_profile":        redshift.ResourceAuthenticationProfile(),
-			"aws_redshift_cluster":                       redshift.ResourceCluster(),
-			"aws_redshift_cluster_iam_roles":             redshift.ResourceClusterIAMRoles(),
-			"aws_redshift_endpoint_access":               redshift.ResourceEndpointAccess(),
-			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
-			"aws_redshift_hsm_client_certificate":        redshift.ResourceHSMClientCertificate(),
-			"aws_redshift_hsm_configuration":             redshift.ResourceHSMConfiguration(),
-			"aws_redshift_parameter_group":               redshift.ResourceParameterGroup(),
-			"aws_redshift_scheduled_action":              redshift.ResourceScheduledAction(),
-			"aws_redshift_security_group":                redshift.ResourceSecurityGroup(),
-			"aws_redshift_snapshot_copy_grant":           redshift.ResourceSnapshotCopyGrant(),
-			"aws_redshift_snapshot_schedule":             redshift.ResourceSnapshotSchedule(),
-			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
-			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
-			"aws_redshift_usage_limit":                   redshift.ResourceUsageLimit(),
+			"aws_redshift_authentication_profile":          redshift.ResourceAuthenticationProfile(),
+			"aws_redshift_cluster":                         redshift.ResourceCluster(),
+			"aws_redshift_cluster_iam_roles":               redshift.ResourceClusterIAMRoles(),
+			"aws_redshift_data_share_authorization":        redshift.ResourceDataShareAuthorization(),
+			"aws_redshift_data_share_consumer_association": redshift.ResourceDataShareConsumerAssociation(),
+			"aws_redshift_endpoint_access":                 redshift.ResourceEndpointAccess(),
+			"aws_redshift_event_subscription":              redshift.ResourceEventSubscription(),
+			"aws_redshift_hsm_client_certificate":          redshift.ResourceHSMClientCertificate(),
+			"aws_redshift_hsm_configuration":               redshift.ResourceHSMConfiguration(),
+			"aws_redshift_parameter_group":                 redshift.ResourceParameterGroup(),
+			"aws_redshift_scheduled_action":                redshift.ResourceScheduledAction(),
+			"aws_redshift_security_group":                  redshift.ResourceSecurityGroup(),
+			"aws_redshift_snapshot_copy_grant":             redshift.ResourceSnapshotCopyGrant(),
+			"aws_redshift_snapshot_schedule":               redshift.ResourceSnapshotSchedule(),
+			"aws_redshift_snapshot_schedule_association":   redshift.ResourceSnapshotScheduleAssociation(),
+			"aws_redshift_subnet_group":                    redshift.ResourceSubnetGroup(),
+			"aws_redshift_usage_limit":                     redshift.ResourceUsageLimit(),
 
 			"aws_redshiftdata_statement": redshiftdata.ResourceStatement(),
 
+			"aws_rekognition_stream_processor": rekognition.ResourceStreamProcessor(),
+
+			"aws_resiliencehub_app":               resiliencehub.ResourceApp(),
+			"aws_resiliencehub_resiliency_policy": resiliencehub.ResourceResiliencyPolicy(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
+			"aws_route53_cidr_collection":               route53.ResourceCIDRCollection(),
+			"aws_route53_cidr_location":                 route53.ResourceCIDRLocation(),
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
 			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
@@ -1907,6 +1969,7 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
+			"aws_sagemaker_pipeline":                                  sagemaker.ResourcePipeline(),
 			"aws_sagemaker_project":                                   sagemaker.ResourceProject(),
 			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
 			"aws_sagemaker_user_profile":                              sagemaker.ResourceUserProfile(),
@@ -1950,13 +2013,20 @@ func Provider() *schema.Provider {
 			"aws_servicecatalog_tag_option":                      servicecatalog.ResourceTagOption(),
 			"aws_servicecatalog_tag_option_resource_association": servicecatalog.ResourceTagOptionResourceAssociation(),
 
+			"aws_servicecatalogappregistry_application":                 servicecatalogappregistry.ResourceApplication(),
+			"aws_servicecatalogappregistry_attribute_group":             servicecatalogappregistry.ResourceAttributeGroup(),
+			"aws_servicecatalogappregistry_attribute_group_association": servicecatalogappregistry.ResourceAttributeGroupAssociation(),
+			"aws_servicecatalogappregistry_resource_association":        servicecatalogappregistry.ResourceResourceAssociation(),
+
 			"aws_service_discovery_http_namespace":        servicediscovery.ResourceHTTPNamespace(),
 			"aws_service_discovery_instance":              servicediscovery.ResourceInstance(),
 			"aws_service_discovery_private_dns_namespace": servicediscovery.ResourcePrivateDNSNamespace(),
 			"aws_service_discovery_public_dns_namespace":  servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":               servicediscovery.ResourceService(),
 
-			"aws_servicequotas_service_quota": servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_service_quota":        servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_template":             servicequotas.ResourceTemplate(),
+			"aws_servicequotas_template_association": servicequotas.ResourceTemplateAssociation(),
 
 			"aws_ses_active_receipt_rule_set":      ses.ResourceActiveReceiptRuleSet(),
 			"aws_ses_configuration_set":            ses.ResourceConfigurationSet(),
@@ -2006,6 +2076,8 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
+			"aws_ssmcontacts_plan": ssmcontacts.ResourcePlan(),
+
 			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
 			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
 			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
@@ -2029,12 +2101,16 @@ func Provider() *schema.Provider {
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
+			"aws_transcribe_call_analytics_category": transcribe.ResourceCallAnalyticsCategory(),
+
 			"aws_transfer_access":   transfer.ResourceAccess(),
 			"aws_transfer_server":   transfer.ResourceServer(),
 			"aws_transfer_ssh_key":  transfer.ResourceSSHKey(),
 			"aws_transfer_user":     transfer.ResourceUser(),
 			"aws_transfer_workflow": transfer.ResourceWorkflow(),
 
+			"aws_translate_parallel_data": translate.ResourceParallelData(),
+
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
 			"aws_waf_ipset":                   waf.ResourceIPSet(),
@@ -2082,6 +2158,9 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	deprecateResources(provider.DataSourcesMap, deprecatedDataSources, "data source")
+	deprecateResources(provider.ResourcesMap, deprecatedResources, "resource")
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -0,0 +1,156 @@
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppStreamEntitlement_basic(t *testing.T) {
+	resourceName := "aws_appstream_entitlement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEntitlementDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntitlementConfig_basic(rName, appstream.AppVisibilityAll, "department", "engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntitlementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_visibility", appstream.AppVisibilityAll),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":  "department",
+						"value": "engineering",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "stack_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntitlementConfig_basic(rName, appstream.AppVisibilityAssociated, "costCenter", "1234"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntitlementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_visibility", appstream.AppVisibilityAssociated),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"name":  "costCenter",
+						"value": "1234",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamEntitlement_disappears(t *testing.T) {
+	resourceName := "aws_appstream_entitlement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEntitlementDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntitlementConfig_basic(rName, appstream.AppVisibilityAll, "department", "engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntitlementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappstream.ResourceEntitlement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntitlementExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+		stackName, name, err := tfappstream.DecodeEntitlementID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error decoding AppStream Entitlement ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		_, err = tfappstream.FindEntitlementByStackAndName(context.TODO(), conn, stackName, name)
+
+		return err
+	}
+}
+
+func testAccCheckEntitlementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appstream_entitlement" {
+			continue
+		}
+
+		stackName, name, err := tfappstream.DecodeEntitlementID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error decoding AppStream Entitlement ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		_, err = tfappstream.FindEntitlementByStackAndName(context.TODO(), conn, stackName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppStream Entitlement %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEntitlementConfig_basic(name, appVisibility, attributeName, attributeValue string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+}
+
+resource "aws_appstream_entitlement" "test" {
+  name           = %[1]q
+  stack_name     = aws_appstream_stack.test.name
+  app_visibility = %[2]q
+
+  attribute {
+    name  = %[3]q
+    value = %[4]q
+  }
+}
+`, name, appVisibility, attributeName, attributeValue)
+}
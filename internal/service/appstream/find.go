@@ -192,3 +192,36 @@ func FindFleetStackAssociation(ctx context.Context, conn *appstream.AppStream, f
 
 	return nil
 }
+
+// FindEntitlementByStackAndName Retrieve a appstream entitlement by stack name and entitlement name
+func FindEntitlementByStackAndName(ctx context.Context, conn *appstream.AppStream, stackName, name string) (*appstream.Entitlement, error) {
+	input := &appstream.DescribeEntitlementsInput{
+		Name:      aws.String(name),
+		StackName: aws.String(stackName),
+	}
+
+	resp, err := conn.DescribeEntitlementsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeEntitlementNotFoundException) || tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || len(resp.Entitlements) == 0 || resp.Entitlements[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if len(resp.Entitlements) > 1 {
+		return nil, fmt.Errorf("got more than one entitlement with the name %s", name)
+	}
+
+	return resp.Entitlements[0], nil
+}
@@ -0,0 +1,171 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssessmentDelegation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentDelegationCreate,
+		ReadWithoutTimeout:   resourceAssessmentDelegationRead,
+		DeleteWithoutTimeout: resourceAssessmentDelegationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 350),
+			},
+			"control_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"delegation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssessmentDelegationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID := d.Get("assessment_id").(string)
+	delegation := &auditmanager.CreateDelegationRequest{
+		ControlSetId: aws.String(d.Get("control_set_id").(string)),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		RoleType:     aws.String(d.Get("role_type").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		delegation.Comment = aws.String(v.(string))
+	}
+
+	input := &auditmanager.BatchCreateDelegationByAssessmentInput{
+		AssessmentId:             aws.String(assessmentID),
+		CreateDelegationRequests: []*auditmanager.CreateDelegationRequest{delegation},
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Assessment Delegation: %s", input)
+	output, err := conn.BatchCreateDelegationByAssessmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Assessment (%s) Delegation: %s", assessmentID, err)
+	}
+
+	// The batch API reports per-delegation failures in the response body.
+	if len(output.Errors) > 0 && output.Errors[0] != nil {
+		return diag.Errorf("creating Audit Manager Assessment (%s) Delegation: %s: %s", assessmentID, aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	if len(output.Delegations) == 0 || output.Delegations[0] == nil {
+		return diag.Errorf("creating Audit Manager Assessment (%s) Delegation: empty result", assessmentID)
+	}
+
+	d.SetId(AssessmentDelegationCreateResourceID(assessmentID, aws.StringValue(output.Delegations[0].Id)))
+
+	return resourceAssessmentDelegationRead(ctx, d, meta)
+}
+
+func resourceAssessmentDelegationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, delegationID, err := AssessmentDelegationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	delegation, err := FindAssessmentDelegationByTwoPartKey(ctx, conn, assessmentID, delegationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment Delegation %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment Delegation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("assessment_id", delegation.AssessmentId)
+	d.Set("comment", delegation.Comment)
+	d.Set("control_set_id", delegation.ControlSetId)
+	d.Set("delegation_id", delegation.Id)
+	d.Set("role_arn", delegation.RoleArn)
+	d.Set("role_type", delegation.RoleType)
+	d.Set("status", delegation.Status)
+
+	return nil
+}
+
+func resourceAssessmentDelegationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, delegationID, err := AssessmentDelegationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Audit Manager Assessment Delegation: %s", d.Id())
+	output, err := conn.BatchDeleteDelegationByAssessmentWithContext(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
+		AssessmentId:  aws.String(assessmentID),
+		DelegationIds: aws.StringSlice([]string{delegationID}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Assessment Delegation (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Errors) > 0 && output.Errors[0] != nil {
+		return diag.Errorf("deleting Audit Manager Assessment Delegation (%s): %s: %s", d.Id(), aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Assessments are not yet managed by the provider, so these tests require an existing
+// assessment and one of its control sets in the current account and region.
+func testAccAssessmentPreCheck(t *testing.T) (string, string) {
+	assessmentID := os.Getenv("AUDITMANAGER_ASSESSMENT_ID")
+	if assessmentID == "" {
+		t.Skip("Environment variable AUDITMANAGER_ASSESSMENT_ID is not set")
+	}
+
+	controlSetID := os.Getenv("AUDITMANAGER_CONTROL_SET_ID")
+	if controlSetID == "" {
+		t.Skip("Environment variable AUDITMANAGER_CONTROL_SET_ID is not set")
+	}
+
+	return assessmentID, controlSetID
+}
+
+func TestAccAuditManagerAssessmentDelegation_basic(t *testing.T) {
+	assessmentID, controlSetID := testAccAssessmentPreCheck(t)
+	var v auditmanager.Delegation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssessmentDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(rName, assessmentID, controlSetID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttr(resourceName, "comment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "control_set_id", controlSetID),
+					resource.TestCheckResourceAttrSet(resourceName, "delegation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "role_type", "RESOURCE_OWNER"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessmentDelegation_disappears(t *testing.T) {
+	assessmentID, controlSetID := testAccAssessmentPreCheck(t)
+	var v auditmanager.Delegation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment_delegation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssessmentDelegationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentDelegationConfig_basic(rName, assessmentID, controlSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentDelegationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceAssessmentDelegation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentDelegationExists(n string, v *auditmanager.Delegation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Assessment Delegation ID is set")
+		}
+
+		assessmentID, delegationID, err := tfauditmanager.AssessmentDelegationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		output, err := tfauditmanager.FindAssessmentDelegationByTwoPartKey(context.TODO(), conn, assessmentID, delegationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssessmentDelegationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment_delegation" {
+			continue
+		}
+
+		assessmentID, delegationID, err := tfauditmanager.AssessmentDelegationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfauditmanager.FindAssessmentDelegationByTwoPartKey(context.TODO(), conn, assessmentID, delegationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Assessment Delegation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAssessmentDelegationConfig_basic(rName, assessmentID, controlSetID string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "auditmanager.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_auditmanager_assessment_delegation" "test" {
+  assessment_id  = %[2]q
+  comment        = "test"
+  control_set_id = %[3]q
+  role_arn       = aws_iam_role.test.arn
+  role_type      = "RESOURCE_OWNER"
+}
+`, rName, assessmentID, controlSetID)
+}
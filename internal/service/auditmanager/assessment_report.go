@@ -0,0 +1,134 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAssessmentReport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentReportCreate,
+		ReadWithoutTimeout:   resourceAssessmentReportRead,
+		DeleteWithoutTimeout: resourceAssessmentReportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"author": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssessmentReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentReportInput{
+		AssessmentId: aws.String(d.Get("assessment_id").(string)),
+		Name:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Assessment Report: %s", input)
+	output, err := conn.CreateAssessmentReportWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Assessment Report (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssessmentReport.Id))
+
+	if _, err := waitAssessmentReportCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Audit Manager Assessment Report (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAssessmentReportRead(ctx, d, meta)
+}
+
+func resourceAssessmentReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	report, err := FindAssessmentReportByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment Report %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment Report (%s): %s", d.Id(), err)
+	}
+
+	d.Set("assessment_id", report.AssessmentId)
+	d.Set("author", report.Author)
+	d.Set("description", report.Description)
+	d.Set("name", report.Name)
+	d.Set("status", report.Status)
+
+	return nil
+}
+
+func resourceAssessmentReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[INFO] Deleting Audit Manager Assessment Report: %s", d.Id())
+	_, err := conn.DeleteAssessmentReportWithContext(ctx, &auditmanager.DeleteAssessmentReportInput{
+		AssessmentId:       aws.String(d.Get("assessment_id").(string)),
+		AssessmentReportId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Assessment Report (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
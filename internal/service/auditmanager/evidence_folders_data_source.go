@@ -0,0 +1,146 @@
+package auditmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceEvidenceFolders() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEvidenceFoldersRead,
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"control_set_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"evidence_folders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assessment_report_selection_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"author": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"control_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"control_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"control_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"evidence_resources_included_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_evidence": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEvidenceFoldersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID := d.Get("assessment_id").(string)
+	evidenceFolders, err := FindEvidenceFoldersByAssessmentID(ctx, conn, assessmentID)
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment (%s) evidence folders: %s", assessmentID, err)
+	}
+
+	if v, ok := d.GetOk("control_set_id"); ok {
+		controlSetID := v.(string)
+		var filtered []*auditmanager.AssessmentEvidenceFolder
+
+		for _, evidenceFolder := range evidenceFolders {
+			if aws.StringValue(evidenceFolder.ControlSetId) == controlSetID {
+				filtered = append(filtered, evidenceFolder)
+			}
+		}
+
+		evidenceFolders = filtered
+	}
+
+	d.SetId(assessmentID)
+
+	if err := d.Set("evidence_folders", flattenAssessmentEvidenceFolders(evidenceFolders)); err != nil {
+		return diag.Errorf("setting evidence_folders: %s", err)
+	}
+
+	return nil
+}
+
+func flattenAssessmentEvidenceFolders(apiObjects []*auditmanager.AssessmentEvidenceFolder) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"assessment_report_selection_count": aws.Int64Value(apiObject.AssessmentReportSelectionCount),
+			"author":                            aws.StringValue(apiObject.Author),
+			"control_id":                        aws.StringValue(apiObject.ControlId),
+			"control_name":                      aws.StringValue(apiObject.ControlName),
+			"control_set_id":                    aws.StringValue(apiObject.ControlSetId),
+			"data_source":                       aws.StringValue(apiObject.DataSource),
+			"evidence_resources_included_count": aws.Int64Value(apiObject.EvidenceResourcesIncludedCount),
+			"id":                                aws.StringValue(apiObject.Id),
+			"name":                              aws.StringValue(apiObject.Name),
+			"total_evidence":                    aws.Int64Value(apiObject.TotalEvidence),
+		}
+
+		if v := apiObject.Date; v != nil {
+			tfMap["date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
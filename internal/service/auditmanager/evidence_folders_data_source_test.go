@@ -0,0 +1,40 @@
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAuditManagerEvidenceFoldersDataSource_basic(t *testing.T) {
+	assessmentID, controlSetID := testAccAssessmentPreCheck(t)
+	dataSourceName := "data.aws_auditmanager_evidence_folders.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvidenceFoldersDataSourceConfig_basic(assessmentID, controlSetID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "assessment_id", assessmentID),
+					resource.TestCheckResourceAttr(dataSourceName, "control_set_id", controlSetID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evidence_folders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvidenceFoldersDataSourceConfig_basic(assessmentID, controlSetID string) string {
+	return fmt.Sprintf(`
+data "aws_auditmanager_evidence_folders" "test" {
+  assessment_id  = %[1]q
+  control_set_id = %[2]q
+}
+`, assessmentID, controlSetID)
+}
@@ -0,0 +1,119 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAssessmentByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Assessment, error) {
+	input := &auditmanager.GetAssessmentInput{
+		AssessmentId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil || output.Assessment.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func FindAssessmentDelegationByTwoPartKey(ctx context.Context, conn *auditmanager.AuditManager, assessmentID, delegationID string) (*auditmanager.Delegation, error) {
+	assessment, err := FindAssessmentByID(ctx, conn, assessmentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range assessment.Metadata.Delegations {
+		if aws.StringValue(v.Id) == delegationID {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindAssessmentReportByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.AssessmentReportMetadata, error) {
+	input := &auditmanager.ListAssessmentReportsInput{}
+	var output *auditmanager.AssessmentReportMetadata
+
+	err := conn.ListAssessmentReportsPagesWithContext(ctx, input, func(page *auditmanager.ListAssessmentReportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssessmentReports {
+			if aws.StringValue(v.Id) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindEvidenceFoldersByAssessmentID(ctx context.Context, conn *auditmanager.AuditManager, id string) ([]*auditmanager.AssessmentEvidenceFolder, error) {
+	input := &auditmanager.GetEvidenceFoldersByAssessmentInput{
+		AssessmentId: aws.String(id),
+	}
+	var output []*auditmanager.AssessmentEvidenceFolder
+
+	err := conn.GetEvidenceFoldersByAssessmentPagesWithContext(ctx, input, func(page *auditmanager.GetEvidenceFoldersByAssessmentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EvidenceFolders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
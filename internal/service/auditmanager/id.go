@@ -0,0 +1,25 @@
+package auditmanager
+
+import (
+	"fmt"
+	"strings"
+)
+
+const assessmentDelegationIDSeparator = ","
+
+func AssessmentDelegationCreateResourceID(assessmentID, delegationID string) string {
+	parts := []string{assessmentID, delegationID}
+	id := strings.Join(parts, assessmentDelegationIDSeparator)
+
+	return id
+}
+
+func AssessmentDelegationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, assessmentDelegationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected assessment-id%[2]sdelegation-id", id, assessmentDelegationIDSeparator)
+}
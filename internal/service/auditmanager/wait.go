@@ -0,0 +1,26 @@
+package auditmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitAssessmentReportCompleted(ctx context.Context, conn *auditmanager.AuditManager, id string, timeout time.Duration) (*auditmanager.AssessmentReportMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{auditmanager.AssessmentReportStatusInProgress},
+		Target:  []string{auditmanager.AssessmentReportStatusComplete},
+		Refresh: statusAssessmentReport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*auditmanager.AssessmentReportMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -3,6 +3,7 @@ package budgets
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -296,10 +297,11 @@ func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("budget_type", budget.BudgetType)
 
 	// `cost_filters` should be removed in future releases
-	if err := d.Set("cost_filter", convertCostFiltersToMap(budget.CostFilters)); err != nil {
+	costFilters := costFiltersInConfiguredOrder(budget.CostFilters, d)
+	if err := d.Set("cost_filter", convertCostFiltersToMap(costFilters)); err != nil {
 		return fmt.Errorf("error setting cost_filter: %w", err)
 	}
-	if err := d.Set("cost_filters", convertCostFiltersToStringMap(budget.CostFilters)); err != nil {
+	if err := d.Set("cost_filters", convertCostFiltersToStringMap(costFilters)); err != nil {
 		return fmt.Errorf("error setting cost_filters: %w", err)
 	}
 
@@ -556,6 +558,58 @@ func convertCostFiltersToStringMap(costFilters map[string][]*string) map[string]
 	return convertedCostFilters
 }
 
+// costFiltersInConfiguredOrder returns the budget's cost filters with each filter's values in the order
+// already held in cost_filter or cost_filters, as AWS may return the same values in a different order.
+func costFiltersInConfiguredOrder(costFilters map[string][]*string, d *schema.ResourceData) map[string][]*string {
+	configured := make(map[string][]string)
+
+	if v, ok := d.GetOk("cost_filter"); ok {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			configured[tfMap["name"].(string)] = aws.StringValueSlice(flex.ExpandStringList(tfMap["values"].([]interface{})))
+		}
+	} else if v, ok := d.GetOk("cost_filters"); ok {
+		for k, v := range v.(map[string]interface{}) {
+			configured[k] = strings.Split(v.(string), ",")
+		}
+	}
+
+	output := make(map[string][]*string, len(costFilters))
+
+	for k, v := range costFilters {
+		if values, ok := configured[k]; ok && stringValuesEqualIgnoringOrder(aws.StringValueSlice(v), values) {
+			output[k] = aws.StringSlice(values)
+		} else {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+func stringValuesEqualIgnoringOrder(s1, s2 []string) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	s1, s2 = append([]string(nil), s1...), append([]string(nil), s2...)
+	sort.Strings(s1)
+	sort.Strings(s2)
+
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func expandBudgetUnmarshal(d *schema.ResourceData) (*budgets.Budget, error) {
 	budgetName := d.Get("name").(string)
 	budgetType := d.Get("budget_type").(string)
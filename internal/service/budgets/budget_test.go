@@ -262,6 +262,35 @@ func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_costFilterValueOrder(t *testing.T) {
+	var budget budgets.Budget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(budgets.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, budgets.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccBudgetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetConfig_costFilterValueOrder(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBudgetExists(resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "cost_filter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cost_filter.*", map[string]string{
+						"name":     "Service",
+						"values.#": "3",
+						"values.0": "Amazon Simple Storage Service",
+						"values.1": "Amazon Elastic Compute Cloud - Compute",
+						"values.2": "AWS Lambda",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudget_notifications(t *testing.T) {
 	var budget budgets.Budget
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -505,6 +534,27 @@ resource "aws_budgets_budget" "test" {
 `, rName, startDate, endDate, acctest.AlternateRegion(), acctest.ThirdRegion())
 }
 
+func testAccBudgetConfig_costFilterValueOrder(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  cost_filter {
+    name = "Service"
+    values = [
+      "Amazon Simple Storage Service",
+      "Amazon Elastic Compute Cloud - Compute",
+      "AWS Lambda",
+    ]
+  }
+}
+`, rName)
+}
+
 func testAccBudgetConfig_notifications(rName, emailAddress1, emailAddress2 string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
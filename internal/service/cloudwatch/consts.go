@@ -32,3 +32,79 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	dashboardPeriodOverrideAuto    = "auto"
+	dashboardPeriodOverrideInherit = "inherit"
+)
+
+func dashboardPeriodOverride_Values() []string {
+	return []string{
+		dashboardPeriodOverrideAuto,
+		dashboardPeriodOverrideInherit,
+	}
+}
+
+const (
+	dashboardWidgetTypeLog    = "log"
+	dashboardWidgetTypeMetric = "metric"
+	dashboardWidgetTypeText   = "text"
+)
+
+const (
+	dashboardMetricWidgetViewBar         = "bar"
+	dashboardMetricWidgetViewGauge       = "gauge"
+	dashboardMetricWidgetViewPie         = "pie"
+	dashboardMetricWidgetViewSingleValue = "singleValue"
+	dashboardMetricWidgetViewTimeSeries  = "timeSeries"
+)
+
+func dashboardMetricWidgetView_Values() []string {
+	return []string{
+		dashboardMetricWidgetViewBar,
+		dashboardMetricWidgetViewGauge,
+		dashboardMetricWidgetViewPie,
+		dashboardMetricWidgetViewSingleValue,
+		dashboardMetricWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardLogWidgetViewBar        = "bar"
+	dashboardLogWidgetViewPie        = "pie"
+	dashboardLogWidgetViewTable      = "table"
+	dashboardLogWidgetViewTimeSeries = "timeSeries"
+)
+
+func dashboardLogWidgetView_Values() []string {
+	return []string{
+		dashboardLogWidgetViewBar,
+		dashboardLogWidgetViewPie,
+		dashboardLogWidgetViewTable,
+		dashboardLogWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardTextWidgetBackgroundSolid       = "solid"
+	dashboardTextWidgetBackgroundTransparent = "transparent"
+)
+
+func dashboardTextWidgetBackground_Values() []string {
+	return []string{
+		dashboardTextWidgetBackgroundSolid,
+		dashboardTextWidgetBackgroundTransparent,
+	}
+}
+
+const (
+	dashboardYAxisLeft  = "left"
+	dashboardYAxisRight = "right"
+)
+
+func dashboardYAxis_Values() []string {
+	return []string{
+		dashboardYAxisLeft,
+		dashboardYAxisRight,
+	}
+}
@@ -0,0 +1,496 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dashboardGridWidth = 24
+)
+
+var dashboardColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func DataSourceDashboardBody() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDashboardBodyRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start"},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: dashboardWidgetSchema(map[string]*schema.Schema{
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"stacked": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"view": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      dashboardLogWidgetViewTable,
+							ValidateFunc: validation.StringInSlice(dashboardLogWidgetView_Values(), false),
+						},
+					}),
+				},
+			},
+			"metric_widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: dashboardWidgetSchema(map[string]*schema.Schema{
+						"metric": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"color": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(dashboardColorRegexp, "must be a hexadecimal color such as #d62728"),
+									},
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"label": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"metric_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"visible": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"y_axis": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardYAxis_Values(), false),
+									},
+								},
+							},
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"stacked": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"stat": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"view": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      dashboardMetricWidgetViewTimeSeries,
+							ValidateFunc: validation.StringInSlice(dashboardMetricWidgetView_Values(), false),
+						},
+					}),
+				},
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dashboardPeriodOverride_Values(), false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"text_widget": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: dashboardWidgetSchema(map[string]*schema.Schema{
+						"background": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(dashboardTextWidgetBackground_Values(), false),
+						},
+						"markdown": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+// dashboardWidgetSchema adds the position and size attributes common to all widget types.
+func dashboardWidgetSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["height"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      6,
+		ValidateFunc: validation.IntBetween(1, 1000),
+	}
+	s["width"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      6,
+		ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+	}
+	s["x"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, dashboardGridWidth-1),
+	}
+	s["y"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+
+	return s
+}
+
+type dashboardBody struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string                 `json:"type"`
+	X          *int                   `json:"x,omitempty"`
+	Y          *int                   `json:"y,omitempty"`
+	Width      int                    `json:"width"`
+	Height     int                    `json:"height"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+func dataSourceDashboardBodyRead(d *schema.ResourceData, meta interface{}) error {
+	region := meta.(*conns.AWSClient).Region
+
+	body := &dashboardBody{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+		Widgets:        []*dashboardWidget{},
+	}
+
+	for i, v := range d.Get("metric_widget").([]interface{}) {
+		widget, err := expandDashboardMetricWidget(v.(map[string]interface{}), region)
+
+		if err != nil {
+			return fmt.Errorf("error building CloudWatch Dashboard body: metric_widget.%d: %w", i, err)
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	for _, v := range d.Get("text_widget").([]interface{}) {
+		body.Widgets = append(body.Widgets, expandDashboardTextWidget(v.(map[string]interface{})))
+	}
+
+	for _, v := range d.Get("log_widget").([]interface{}) {
+		body.Widgets = append(body.Widgets, expandDashboardLogWidget(v.(map[string]interface{}), region))
+	}
+
+	for i, widget := range body.Widgets {
+		if widget.X != nil && *widget.X+widget.Width > dashboardGridWidth {
+			return fmt.Errorf("error building CloudWatch Dashboard body: %s widget (%d) extends past the %d column grid", widget.Type, i, dashboardGridWidth)
+		}
+	}
+
+	jsonDoc, err := json.MarshalIndent(body, "", "  ")
+
+	if err != nil {
+		// should never happen if the above code is correct
+		return fmt.Errorf("error building CloudWatch Dashboard body: %w", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return nil
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, widgetType string) *dashboardWidget {
+	widget := &dashboardWidget{
+		Type:       widgetType,
+		Width:      tfMap["width"].(int),
+		Height:     tfMap["height"].(int),
+		Properties: map[string]interface{}{},
+	}
+
+	// Widgets without explicit coordinates are placed automatically by CloudWatch.
+	if v, ok := tfMap["x"].(int); ok && v > 0 {
+		widget.X = &v
+	}
+
+	if v, ok := tfMap["y"].(int); ok && v > 0 {
+		widget.Y = &v
+	}
+
+	if widget.X != nil && widget.Y == nil {
+		v := 0
+		widget.Y = &v
+	}
+
+	if widget.Y != nil && widget.X == nil {
+		v := 0
+		widget.X = &v
+	}
+
+	return widget
+}
+
+func expandDashboardMetricWidget(tfMap map[string]interface{}, region string) (*dashboardWidget, error) {
+	widget := expandDashboardWidget(tfMap, dashboardWidgetTypeMetric)
+
+	widget.Properties["region"] = region
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		widget.Properties["region"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v > 0 {
+		widget.Properties["period"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		widget.Properties["stacked"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		widget.Properties["stat"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		widget.Properties["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		widget.Properties["view"] = v
+	}
+
+	var metrics []interface{}
+
+	for i, v := range tfMap["metric"].([]interface{}) {
+		metric, err := expandDashboardMetric(v.(map[string]interface{}))
+
+		if err != nil {
+			return nil, fmt.Errorf("metric.%d: %w", i, err)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	widget.Properties["metrics"] = metrics
+
+	// Round-trip through JSON so that validation sees exactly what PutDashboard will.
+	var properties map[string]interface{}
+	if b, err := json.Marshal(widget.Properties); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(b, &properties); err != nil {
+		return nil, err
+	}
+
+	if errs := validDashboardMetricWidgetProperties(properties); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return widget, nil
+}
+
+// expandDashboardMetric returns the array form of a metric used in a metric widget's "metrics" property:
+// [Namespace, MetricName, DimensionName, DimensionValue, ..., {rendering properties}].
+func expandDashboardMetric(tfMap map[string]interface{}) ([]interface{}, error) {
+	namespace := tfMap["namespace"].(string)
+	metricName := tfMap["metric_name"].(string)
+	expression := tfMap["expression"].(string)
+	id := tfMap["id"].(string)
+
+	if expression != "" && (namespace != "" || metricName != "") {
+		return nil, fmt.Errorf("only one of expression or namespace and metric_name can be specified")
+	}
+
+	if expression == "" && (namespace == "" || metricName == "") {
+		return nil, fmt.Errorf("one of expression or namespace and metric_name must be specified")
+	}
+
+	if expression != "" && id == "" {
+		return nil, fmt.Errorf("id must be specified with expression")
+	}
+
+	var metric []interface{}
+	options := map[string]interface{}{}
+
+	if expression != "" {
+		options["expression"] = expression
+	} else {
+		metric = append(metric, namespace, metricName)
+
+		dimensions := tfMap["dimensions"].(map[string]interface{})
+		keys := make([]string, 0, len(dimensions))
+		for k := range dimensions {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			metric = append(metric, k, dimensions[k].(string))
+		}
+	}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		options["accountId"] = v
+	}
+
+	if v, ok := tfMap["color"].(string); ok && v != "" {
+		options["color"] = v
+	}
+
+	if id != "" {
+		options["id"] = id
+	}
+
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options["label"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v > 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		options["region"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options["stat"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = v
+	}
+
+	if v, ok := tfMap["y_axis"].(string); ok && v != "" {
+		options["yAxis"] = v
+	}
+
+	if len(options) > 0 {
+		metric = append(metric, options)
+	}
+
+	return metric, nil
+}
+
+func expandDashboardTextWidget(tfMap map[string]interface{}) *dashboardWidget {
+	widget := expandDashboardWidget(tfMap, dashboardWidgetTypeText)
+
+	widget.Properties["markdown"] = tfMap["markdown"].(string)
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		widget.Properties["background"] = v
+	}
+
+	return widget
+}
+
+func expandDashboardLogWidget(tfMap map[string]interface{}, region string) *dashboardWidget {
+	widget := expandDashboardWidget(tfMap, dashboardWidgetTypeLog)
+
+	widget.Properties["query"] = tfMap["query"].(string)
+
+	widget.Properties["region"] = region
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		widget.Properties["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		widget.Properties["stacked"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		widget.Properties["title"] = v
+	}
+
+	if v, ok := tfMap["view"].(string); ok && v != "" {
+		widget.Properties["view"] = v
+	}
+
+	return widget
+}
@@ -0,0 +1,172 @@
+package cloudwatch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudWatchDashboardBodyDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudwatch_dashboard_body.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardBodyDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccDashboardBodyExpectedJSON()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardBodyDataSource_expressionWithoutID(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardBodyDataSourceConfig_expressionWithoutID,
+				ExpectError: regexp.MustCompile(`id must be specified with expression`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardBodyDataSource_widgetOutsideGrid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardBodyDataSourceConfig_widgetOutsideGrid,
+				ExpectError: regexp.MustCompile(`extends past the 24 column grid`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardBodyDataSource_dashboard(t *testing.T) {
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardBodyDataSourceConfig_dashboard(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(resourceName, &dashboard),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "dashboard_body", testAccDashboardBodyExpectedJSON()),
+				),
+			},
+		},
+	})
+}
+
+func testAccDashboardBodyExpectedJSON() string {
+	return fmt.Sprintf(`{
+  "widgets": [
+    {
+      "type": "metric",
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "InstanceId", "i-0123456789abcdef0", {"stat": "Average"}],
+          ["AWS/EC2", "CPUUtilization", {"accountId": "123456789012", "label": "Other account"}]
+        ],
+        "region": %[1]q,
+        "title": "CPU",
+        "view": "timeSeries"
+      }
+    },
+    {
+      "type": "text",
+      "x": 12,
+      "y": 0,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "markdown": "Hello world"
+      }
+    }
+  ]
+}`, acctest.Region())
+}
+
+const testAccDashboardBodyDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_body" "test" {
+  metric_widget {
+    width = 12
+    title = "CPU"
+
+    metric {
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+      stat        = "Average"
+
+      dimensions = {
+        InstanceId = "i-0123456789abcdef0"
+      }
+    }
+
+    metric {
+      namespace   = "AWS/EC2"
+      metric_name = "CPUUtilization"
+      account_id  = "123456789012"
+      label       = "Other account"
+    }
+  }
+
+  text_widget {
+    x        = 12
+    width    = 12
+    markdown = "Hello world"
+  }
+}
+`
+
+func testAccDashboardBodyDataSourceConfig_dashboard(rInt int) string {
+	return acctest.ConfigCompose(testAccDashboardBodyDataSourceConfig_basic, fmt.Sprintf(`
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = "terraform-test-dashboard-%[1]d"
+  dashboard_body = data.aws_cloudwatch_dashboard_body.test.json
+}
+`, rInt))
+}
+
+const testAccDashboardBodyDataSourceConfig_expressionWithoutID = `
+data "aws_cloudwatch_dashboard_body" "test" {
+  metric_widget {
+    metric {
+      expression = "SUM(METRICS())"
+    }
+  }
+}
+`
+
+const testAccDashboardBodyDataSourceConfig_widgetOutsideGrid = `
+data "aws_cloudwatch_dashboard_body" "test" {
+  text_widget {
+    x        = 20
+    width    = 6
+    markdown = "Hello world"
+  }
+}
+`
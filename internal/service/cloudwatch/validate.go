@@ -36,3 +36,82 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+var dashboardAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
+// validDashboardMetricWidgetProperties validates the properties of a metric widget rendered by
+// the aws_cloudwatch_dashboard_body data source before the body is passed to PutDashboard.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html#CloudWatch-Dashboard-Properties-Metric-Widget-Object
+func validDashboardMetricWidgetProperties(properties map[string]interface{}) []error {
+	var errors []error
+
+	if properties == nil {
+		return append(errors, fmt.Errorf("metric widget must have properties"))
+	}
+
+	if v, ok := properties["region"].(string); !ok || v == "" {
+		errors = append(errors, fmt.Errorf("metric widget must specify a region"))
+	}
+
+	metrics, hasMetrics := properties["metrics"].([]interface{})
+	annotations, _ := properties["annotations"].(map[string]interface{})
+	if _, hasAlarms := annotations["alarms"]; !hasMetrics && !hasAlarms {
+		errors = append(errors, fmt.Errorf("metric widget must specify metrics or alarm annotations"))
+	}
+
+	for i, v := range metrics {
+		metric, ok := v.([]interface{})
+		if !ok || len(metric) == 0 {
+			errors = append(errors, fmt.Errorf("metrics[%d]: must be a non-empty array", i))
+			continue
+		}
+
+		var names []string
+		var options map[string]interface{}
+		for j, v := range metric {
+			switch v := v.(type) {
+			case string:
+				if options != nil {
+					errors = append(errors, fmt.Errorf("metrics[%d]: rendering properties must be the last element", i))
+				}
+				names = append(names, v)
+			case map[string]interface{}:
+				if j != len(metric)-1 {
+					errors = append(errors, fmt.Errorf("metrics[%d]: rendering properties must be the last element", i))
+				}
+				options = v
+			default:
+				errors = append(errors, fmt.Errorf("metrics[%d][%d]: must be a string or an object", i, j))
+			}
+		}
+
+		if len(names) == 0 {
+			if _, ok := options["expression"]; !ok {
+				errors = append(errors, fmt.Errorf("metrics[%d]: must specify a namespace and metric name or an expression", i))
+			}
+		} else if !dashboardMetricUsesShorthand(names) && (len(names) < 2 || len(names)%2 != 0) {
+			errors = append(errors, fmt.Errorf("metrics[%d]: must specify a namespace, a metric name and dimension name/value pairs", i))
+		}
+
+		// Cross-account metrics are identified by the source account's ID.
+		if v, ok := options["accountId"]; ok {
+			if v, ok := v.(string); !ok || !dashboardAccountIDRegexp.MatchString(v) {
+				errors = append(errors, fmt.Errorf("metrics[%d]: accountId must be a 12-digit AWS account ID", i))
+			}
+		}
+	}
+
+	return errors
+}
+
+// dashboardMetricUsesShorthand returns whether a metric array uses the "." or "..." shorthand
+// to repeat values of the previous metric.
+func dashboardMetricUsesShorthand(names []string) bool {
+	for _, v := range names {
+		if v == "." || v == "..." {
+			return true
+		}
+	}
+
+	return false
+}
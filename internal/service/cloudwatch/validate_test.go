@@ -1,6 +1,7 @@
 package cloudwatch
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -65,3 +66,45 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidDashboardMetricWidgetProperties(t *testing.T) {
+	validProperties := []string{
+		`{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId", "i-0123456789abcdef0"]]}`,                               //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization"], ["...", "InstanceId", "i-0123456789abcdef0"]]}`,                      //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId", "i-0123456789abcdef0"], [".", "NetworkIn", ".", "."]]}`, //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"accountId": "123456789012"}]]}`,                                     //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [[{"expression": "SUM(METRICS())", "id": "e1"}]]}`,                                                   //lintignore:AWSAT003
+		`{"region": "us-west-2", "annotations": {"alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"]}}`,                           //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validProperties {
+		var properties map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &properties); err != nil {
+			t.Fatal(err)
+		}
+
+		if errors := validDashboardMetricWidgetProperties(properties); len(errors) != 0 {
+			t.Fatalf("%s should be valid metric widget properties: %q", v, errors)
+		}
+	}
+
+	invalidProperties := []string{
+		`{"metrics": [["AWS/EC2", "CPUUtilization"]]}`,
+		`{"region": "us-west-2"}`,                                                                    //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [[]]}`,                                                   //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [["AWS/EC2"]]}`,                                          //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId"]]}`,          //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [[{"id": "e1"}]]}`,                                       //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [[{"label": "x"}, "AWS/EC2", "CPUUtilization"]]}`,        //lintignore:AWSAT003
+		`{"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", {"accountId": "1234"}]]}`, //lintignore:AWSAT003
+	}
+	for _, v := range invalidProperties {
+		var properties map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &properties); err != nil {
+			t.Fatal(err)
+		}
+
+		if errors := validDashboardMetricWidgetProperties(properties); len(errors) == 0 {
+			t.Fatalf("%s should be invalid metric widget properties", v)
+		}
+	}
+}
@@ -105,3 +105,30 @@ func FindLambdaFunctionAssociationByARNWithContext(ctx context.Context, conn *co
 
 	return result, nil
 }
+
+func FindInstanceStorageConfigByThreePartKeyWithContext(ctx context.Context, conn *connect.Connect, instanceID, associationID, resourceType string) (*connect.InstanceStorageConfig, error) {
+	input := &connect.DescribeInstanceStorageConfigInput{
+		AssociationId: aws.String(associationID),
+		InstanceId:    aws.String(instanceID),
+		ResourceType:  aws.String(resourceType),
+	}
+
+	output, err := conn.DescribeInstanceStorageConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageConfig, nil
+}
@@ -6,6 +6,7 @@ import (
 )
 
 const botV1AssociationIDSeparator = ":"
+const instanceStorageConfigIDSeparator = ":"
 const lambdaFunctionAssociationIDSeparator = ","
 
 func BotV1AssociationParseResourceID(id string) (string, string, string, error) {
@@ -42,3 +43,20 @@ func LambdaFunctionAssociationCreateResourceID(instanceID string, functionArn st
 
 	return id
 }
+
+func InstanceStorageConfigParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, instanceStorageConfigIDSeparator, 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of Connect Instance Storage Config ID (%s), expected instanceID:associationID:resourceType", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func InstanceStorageConfigCreateResourceID(instanceID string, associationID string, resourceType string) string {
+	parts := []string{instanceID, associationID, resourceType}
+	id := strings.Join(parts, instanceStorageConfigIDSeparator)
+
+	return id
+}
@@ -0,0 +1,417 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceStorageConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInstanceStorageConfigCreate,
+		ReadContext:   resourceInstanceStorageConfigRead,
+		UpdateContext: resourceInstanceStorageConfigUpdate,
+		DeleteContext: resourceInstanceStorageConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.InstanceStorageResourceType_Values(), false),
+			},
+			"storage_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_firehose_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"firehose_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"kinesis_stream_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"kinesis_video_stream_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_config": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     instanceStorageConfigEncryptionConfigSchema(),
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Required: true,
+										// The API appends "-connect-<instance alias>-contact-" to the configured prefix.
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											return strings.HasPrefix(old, new+"-connect-")
+										},
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"retention_period_hours": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 87600),
+									},
+								},
+							},
+						},
+						"s3_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"bucket_prefix": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"encryption_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     instanceStorageConfigEncryptionConfigSchema(),
+									},
+								},
+							},
+						},
+						"storage_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.StorageType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: resourceInstanceStorageConfigCustomizeDiff,
+	}
+}
+
+func instanceStorageConfigEncryptionConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"encryption_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(connect.EncryptionType_Values(), false),
+			},
+			"key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+// storageTypeConfigKeys maps each storage type to the storage_config block that configures it.
+var storageTypeConfigKeys = map[string]string{
+	connect.StorageTypeKinesisFirehose:    "kinesis_firehose_config",
+	connect.StorageTypeKinesisStream:      "kinesis_stream_config",
+	connect.StorageTypeKinesisVideoStream: "kinesis_video_stream_config",
+	connect.StorageTypeS3:                 "s3_config",
+}
+
+// resourceInstanceStorageConfigCustomizeDiff checks that storage_config contains exactly the block that
+// matches storage_type, which the API otherwise reports only when the association is created or updated.
+func resourceInstanceStorageConfigCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	storageType := diff.Get("storage_config.0.storage_type").(string)
+
+	if storageType == "" {
+		return nil
+	}
+
+	if key := storageTypeConfigKeys[storageType]; len(diff.Get("storage_config.0."+key).([]interface{})) == 0 {
+		return fmt.Errorf("storage_config.0.%s must be specified when storage_type is %s", key, storageType)
+	}
+
+	for t, key := range storageTypeConfigKeys {
+		if t != storageType && len(diff.Get("storage_config.0."+key).([]interface{})) > 0 {
+			return fmt.Errorf("storage_config.0.%s cannot be specified when storage_type is %s", key, storageType)
+		}
+	}
+
+	return nil
+}
+
+func resourceInstanceStorageConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID := d.Get("instance_id").(string)
+	resourceType := d.Get("resource_type").(string)
+
+	input := &connect.AssociateInstanceStorageConfigInput{
+		InstanceId:    aws.String(instanceID),
+		ResourceType:  aws.String(resourceType),
+		StorageConfig: expandInstanceStorageConfig(d.Get("storage_config").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Connect Instance Storage Config %s", input)
+	output, err := conn.AssociateInstanceStorageConfigWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Instance Storage Config (%s,%s): %w", instanceID, resourceType, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Instance Storage Config (%s,%s): empty output", instanceID, resourceType))
+	}
+
+	d.SetId(InstanceStorageConfigCreateResourceID(instanceID, aws.StringValue(output.AssociationId), resourceType))
+
+	return resourceInstanceStorageConfigRead(ctx, d, meta)
+}
+
+func resourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, associationID, resourceType, err := InstanceStorageConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	storageConfig, err := FindInstanceStorageConfigByThreePartKeyWithContext(ctx, conn, instanceID, associationID, resourceType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Instance Storage Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Connect Instance Storage Config (%s): %w", d.Id(), err))
+	}
+
+	d.Set("association_id", associationID)
+	d.Set("instance_id", instanceID)
+	d.Set("resource_type", resourceType)
+
+	if err := d.Set("storage_config", flattenInstanceStorageConfig(storageConfig)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting storage_config: %w", err))
+	}
+
+	return nil
+}
+
+func resourceInstanceStorageConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, associationID, resourceType, err := InstanceStorageConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("storage_config") {
+		input := &connect.UpdateInstanceStorageConfigInput{
+			AssociationId: aws.String(associationID),
+			InstanceId:    aws.String(instanceID),
+			ResourceType:  aws.String(resourceType),
+			StorageConfig: expandInstanceStorageConfig(d.Get("storage_config").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Connect Instance Storage Config %s", input)
+		_, err := conn.UpdateInstanceStorageConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Instance Storage Config (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceInstanceStorageConfigRead(ctx, d, meta)
+}
+
+func resourceInstanceStorageConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, associationID, resourceType, err := InstanceStorageConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Instance Storage Config: %s", d.Id())
+	_, err = conn.DisassociateInstanceStorageConfigWithContext(ctx, &connect.DisassociateInstanceStorageConfigInput{
+		AssociationId: aws.String(associationID),
+		InstanceId:    aws.String(instanceID),
+		ResourceType:  aws.String(resourceType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Connect Instance Storage Config (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandInstanceStorageConfig(tfList []interface{}) *connect.InstanceStorageConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connect.InstanceStorageConfig{
+		StorageType: aws.String(tfMap["storage_type"].(string)),
+	}
+
+	if v, ok := tfMap["kinesis_firehose_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisFirehoseConfig = &connect.KinesisFirehoseConfig{
+			FirehoseArn: aws.String(v[0].(map[string]interface{})["firehose_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["kinesis_stream_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamConfig = &connect.KinesisStreamConfig{
+			StreamArn: aws.String(v[0].(map[string]interface{})["stream_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["kinesis_video_stream_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.KinesisVideoStreamConfig = &connect.KinesisVideoStreamConfig{
+			EncryptionConfig:     expandInstanceStorageConfigEncryptionConfig(tfMap["encryption_config"].([]interface{})),
+			Prefix:               aws.String(tfMap["prefix"].(string)),
+			RetentionPeriodHours: aws.Int64(int64(tfMap["retention_period_hours"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["s3_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3Config = &connect.S3Config{
+			BucketName:       aws.String(tfMap["bucket_name"].(string)),
+			BucketPrefix:     aws.String(tfMap["bucket_prefix"].(string)),
+			EncryptionConfig: expandInstanceStorageConfigEncryptionConfig(tfMap["encryption_config"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandInstanceStorageConfigEncryptionConfig(tfList []interface{}) *connect.EncryptionConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &connect.EncryptionConfig{
+		EncryptionType: aws.String(tfMap["encryption_type"].(string)),
+		KeyId:          aws.String(tfMap["key_id"].(string)),
+	}
+}
+
+func flattenInstanceStorageConfig(apiObject *connect.InstanceStorageConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"storage_type": aws.StringValue(apiObject.StorageType),
+	}
+
+	if v := apiObject.KinesisFirehoseConfig; v != nil {
+		tfMap["kinesis_firehose_config"] = []interface{}{map[string]interface{}{
+			"firehose_arn": aws.StringValue(v.FirehoseArn),
+		}}
+	}
+
+	if v := apiObject.KinesisStreamConfig; v != nil {
+		tfMap["kinesis_stream_config"] = []interface{}{map[string]interface{}{
+			"stream_arn": aws.StringValue(v.StreamArn),
+		}}
+	}
+
+	if v := apiObject.KinesisVideoStreamConfig; v != nil {
+		tfMap["kinesis_video_stream_config"] = []interface{}{map[string]interface{}{
+			"encryption_config":      flattenInstanceStorageConfigEncryptionConfig(v.EncryptionConfig),
+			"prefix":                 aws.StringValue(v.Prefix),
+			"retention_period_hours": aws.Int64Value(v.RetentionPeriodHours),
+		}}
+	}
+
+	if v := apiObject.S3Config; v != nil {
+		tfMap["s3_config"] = []interface{}{map[string]interface{}{
+			"bucket_name":       aws.StringValue(v.BucketName),
+			"bucket_prefix":     aws.StringValue(v.BucketPrefix),
+			"encryption_config": flattenInstanceStorageConfigEncryptionConfig(v.EncryptionConfig),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInstanceStorageConfigEncryptionConfig(apiObject *connect.EncryptionConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"encryption_type": aws.StringValue(apiObject.EncryptionType),
+		"key_id":          aws.StringValue(apiObject.KeyId),
+	}}
+}
@@ -467,7 +467,9 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if awaitUpdate {
 		log.Printf("[DEBUG] Waiting for update: %s", d.Id())
-		pending := []string{"modifying"}
+		// Parameter group and maintenance window changes can leave the cluster available while its nodes
+		// reboot or apply parameters; the refresh function reports those as "creating" and "modifying".
+		pending := []string{"creating", "modifying"}
 		stateConf := &resource.StateChangeConf{
 			Pending:    pending,
 			Target:     []string{"available"},
@@ -615,7 +617,12 @@ func clusterStateRefreshFunc(conn *dax.DAX, clusterID, givenState string, pendin
 			// check to make sure we have the node count we're expecting
 			if int64(len(c.Nodes)) != aws.Int64Value(c.TotalNodes) {
 				log.Printf("[DEBUG] Node count is not what is expected: %d found, %d expected", len(c.Nodes), *c.TotalNodes)
-				return nil, "creating", nil
+				return c, "creating", nil
+			}
+
+			if c.ParameterGroup != nil && aws.StringValue(c.ParameterGroup.ParameterApplyStatus) == clusterParameterApplyStatusApplying {
+				log.Printf("[DEBUG] DAX Cluster (%s) parameter group is being applied", clusterID)
+				return c, "modifying", nil
 			}
 
 			log.Printf("[DEBUG] Node count matched (%d)", len(c.Nodes))
@@ -624,7 +631,7 @@ func clusterStateRefreshFunc(conn *dax.DAX, clusterID, givenState string, pendin
 				log.Printf("[DEBUG] Checking cache node for status: %s", n)
 				if n.NodeStatus != nil && aws.StringValue(n.NodeStatus) != "available" {
 					log.Printf("[DEBUG] Node (%s) is not yet available, status: %s", *n.NodeId, *n.NodeStatus)
-					return nil, "creating", nil
+					return c, "creating", nil
 				}
 				log.Printf("[DEBUG] Cache node not in expected state")
 			}
@@ -116,6 +116,46 @@ func TestAccDAXCluster_resize(t *testing.T) {
 	})
 }
 
+func TestAccDAXCluster_parameterGroupAndMaintenanceWindow(t *testing.T) {
+	var dc dax.Cluster
+	rString := sdkacctest.RandString(10)
+	resourceName := "aws_dax_cluster.test"
+	parameterGroupResourceName := "aws_dax_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dax.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dc),
+					resource.TestMatchResourceAttr(
+						resourceName, "parameter_group_name", regexp.MustCompile(`^default.dax`)),
+				),
+			},
+			{
+				Config: testAccClusterConfig_parameterGroupAndMaintenanceWindow(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dc),
+					resource.TestCheckResourceAttr(
+						resourceName, "maintenance_window", "sun:05:00-sun:09:00"),
+					resource.TestCheckResourceAttrPair(resourceName, "parameter_group_name", parameterGroupResourceName, "name"),
+					resource.TestCheckResourceAttr(
+						resourceName, "nodes.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 	var dc dax.Cluster
 	rString := sdkacctest.RandString(10)
@@ -431,3 +471,30 @@ resource "aws_dax_cluster" "test" {
 }
 `, baseConfig, rString)
 }
+
+func testAccClusterConfig_parameterGroupAndMaintenanceWindow(rString string) string {
+	return fmt.Sprintf(`%[1]s
+resource "aws_dax_parameter_group" "test" {
+  name = "tf-%[2]s"
+
+  parameters {
+    name  = "query-ttl-millis"
+    value = "100000"
+  }
+}
+
+resource "aws_dax_cluster" "test" {
+  cluster_name         = "tf-%[2]s"
+  iam_role_arn         = aws_iam_role.test.arn
+  node_type            = "dax.t2.small"
+  replication_factor   = 1
+  description          = "test cluster"
+  maintenance_window   = "sun:05:00-sun:09:00"
+  parameter_group_name = aws_dax_parameter_group.test.name
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, baseConfig, rString)
+}
@@ -5,3 +5,7 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	clusterParameterApplyStatusApplying = "applying"
+)
@@ -20,6 +20,12 @@ func TestAccDetective_serial(t *testing.T) {
 			"disappear": testAccMember_disappears,
 			"message":   testAccMember_message,
 		},
+		"OrganizationAdminAccount": {
+			"basic": testAccOrganizationAdminAccount_basic,
+		},
+		"OrganizationConfiguration": {
+			"basic": testAccOrganizationConfiguration_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -78,6 +78,10 @@ func ResourceMember() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"volume_usage_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -173,6 +177,11 @@ func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("status", resp.Status)
 	d.Set("updated_time", aws.TimeValue(resp.UpdatedTime).Format(time.RFC3339))
 	d.Set("volume_usage_in_bytes", resp.VolumeUsageInBytes)
+	if resp.VolumeUsageUpdatedTime != nil {
+		d.Set("volume_usage_updated_time", aws.TimeValue(resp.VolumeUsageUpdatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("volume_usage_updated_time", nil)
+	}
 
 	return nil
 }
@@ -0,0 +1,111 @@
+package detective
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOrganizationAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationAdminAccountCreate,
+		ReadContext:   resourceOrganizationAdminAccountRead,
+		DeleteContext: resourceOrganizationAdminAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"graph_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationAdminAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	accountID := d.Get("account_id").(string)
+	input := &detective.EnableOrganizationAdminAccountInput{
+		AccountId: aws.String(accountID),
+	}
+
+	_, err := conn.EnableOrganizationAdminAccountWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error enabling Detective Organization Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	_, err = tfresource.RetryWhenNotFoundContext(ctx, OrganizationAdminAccountPropagationTimeout, func() (interface{}, error) {
+		return FindOrganizationAdminAccountByAccountID(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return diag.Errorf("error waiting for Detective Organization Admin Account (%s) to enable: %s", d.Id(), err)
+	}
+
+	return resourceOrganizationAdminAccountRead(ctx, d, meta)
+}
+
+func resourceOrganizationAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	administrator, err := FindOrganizationAdminAccountByAccountID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Organization Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Organization Admin Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", administrator.AccountId)
+	d.Set("graph_arn", administrator.GraphArn)
+
+	return nil
+}
+
+func resourceOrganizationAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	log.Printf("[INFO] Disabling Detective Organization Admin Account: %s", d.Id())
+	_, err := conn.DisableOrganizationAdminAccountWithContext(ctx, &detective.DisableOrganizationAdminAccountInput{})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error disabling Detective Organization Admin Account (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFoundContext(ctx, OrganizationAdminAccountPropagationTimeout, func() (interface{}, error) {
+		return FindOrganizationAdminAccountByAccountID(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return diag.Errorf("error waiting for Detective Organization Admin Account (%s) to disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -13,6 +13,8 @@ const (
 	GraphOperationTimeout = 4 * time.Minute
 	// MemberStatusPropagationTimeout Maximum amount of time to wait for a detective member status to return Invited
 	MemberStatusPropagationTimeout = 4 * time.Minute
+	// OrganizationAdminAccountPropagationTimeout Maximum amount of time to wait for a detective organization admin account to be enabled, disabled
+	OrganizationAdminAccountPropagationTimeout = 4 * time.Minute
 )
 
 // MemberStatusUpdated waits for an AdminAccount and graph arn to return Invited
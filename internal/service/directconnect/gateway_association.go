@@ -116,21 +116,10 @@ func resourceGatewayAssociationCreate(d *schema.ResourceData, meta interface{})
 
 	if associatedGatewayOwnerAccount := d.Get("associated_gateway_owner_account_id").(string); associatedGatewayOwnerAccount != "" {
 		proposalID := d.Get("proposal_id").(string)
-		input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
-			AssociatedGatewayOwnerAccount: aws.String(associatedGatewayOwnerAccount),
-			DirectConnectGatewayId:        aws.String(directConnectGatewayID),
-			ProposalId:                    aws.String(proposalID),
-		}
-
-		if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
-			input.OverrideAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(v.(*schema.Set).List())
-		}
-
-		log.Printf("[DEBUG] Accepting Direct Connect Gateway Association Proposal: %s", input)
-		output, err := conn.AcceptDirectConnectGatewayAssociationProposal(input)
+		output, err := acceptGatewayAssociationProposal(conn, d, proposalID)
 
 		if err != nil {
-			return fmt.Errorf("error accepting Direct Connect Gateway Association Proposal (%s): %w", proposalID, err)
+			return err
 		}
 
 		// For historical reasons the resource ID isn't set to the association ID returned from the API.
@@ -203,6 +192,20 @@ func resourceGatewayAssociationUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
 	associationID := d.Get("dx_gateway_association_id").(string)
+
+	// Cross-account associations are updated by accepting a new proposal from the associated gateway owner.
+	if d.HasChange("proposal_id") && d.Get("associated_gateway_owner_account_id").(string) != "" {
+		if _, err := acceptGatewayAssociationProposal(conn, d, d.Get("proposal_id").(string)); err != nil {
+			return err
+		}
+
+		if _, err := waitGatewayAssociationUpdated(conn, associationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Direct Connect Gateway Association (%s) to update: %w", d.Id(), err)
+		}
+
+		return resourceGatewayAssociationRead(d, meta)
+	}
+
 	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
 		AssociationId: aws.String(associationID),
 	}
@@ -257,6 +260,36 @@ func resourceGatewayAssociationDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func acceptGatewayAssociationProposal(conn *directconnect.DirectConnect, d *schema.ResourceData, proposalID string) (*directconnect.AcceptDirectConnectGatewayAssociationProposalOutput, error) {
+	// The proposal may have been created by another provider in the same apply.
+	_, err := tfresource.RetryWhenNotFound(gatewayAssociationProposalPropagationTimeout, func() (interface{}, error) {
+		return FindGatewayAssociationProposalByID(conn, proposalID)
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Direct Connect Gateway Association Proposal (%s): %w", proposalID, err)
+	}
+
+	input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
+		AssociatedGatewayOwnerAccount: aws.String(d.Get("associated_gateway_owner_account_id").(string)),
+		DirectConnectGatewayId:        aws.String(d.Get("dx_gateway_id").(string)),
+		ProposalId:                    aws.String(proposalID),
+	}
+
+	if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+		input.OverrideAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Accepting Direct Connect Gateway Association Proposal: %s", input)
+	output, err := conn.AcceptDirectConnectGatewayAssociationProposal(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error accepting Direct Connect Gateway Association Proposal (%s): %w", proposalID, err)
+	}
+
+	return output, nil
+}
+
 func resourceGatewayAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*conns.AWSClient).DirectConnectConn
 
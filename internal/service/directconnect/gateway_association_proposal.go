@@ -145,8 +145,11 @@ func resourceGatewayAssociationProposalRead(d *schema.ResourceData, meta interfa
 		// to artificially populate the missing proposal in state as if it was still there.
 		log.Printf("[INFO] Direct Connect Gateway Association Proposal (%s) has reached end-of-life and has been removed by AWS", d.Id())
 
-		if err := d.Set("allowed_prefixes", flattenRouteFilterPrefixes(output.AllowedPrefixesToDirectConnectGateway)); err != nil {
-			return fmt.Errorf("error setting allowed_prefixes: %w", err)
+		// The accepter may have overridden the proposed prefixes; those are reconciled by the aws_dx_gateway_association resource.
+		if d.Get("allowed_prefixes").(*schema.Set).Len() == 0 {
+			if err := d.Set("allowed_prefixes", flattenRouteFilterPrefixes(output.AllowedPrefixesToDirectConnectGateway)); err != nil {
+				return fmt.Errorf("error setting allowed_prefixes: %w", err)
+			}
 		}
 
 		d.Set("associated_gateway_id", output.AssociatedGateway.Id)
@@ -157,8 +160,12 @@ func resourceGatewayAssociationProposalRead(d *schema.ResourceData, meta interfa
 	} else if err != nil {
 		return fmt.Errorf("error reading Direct Connect Gateway Association Proposal (%s): %w", d.Id(), err)
 	} else {
-		if err := d.Set("allowed_prefixes", flattenRouteFilterPrefixes(output.RequestedAllowedPrefixesToDirectConnectGateway)); err != nil {
-			return fmt.Errorf("error setting allowed_prefixes: %w", err)
+		// Accepting the proposal with overridden prefixes changes the returned RequestedAllowedPrefixesToDirectConnectGateway value.
+		// Once accepted, keep the proposed prefixes so that the override doesn't show as drift.
+		if aws.StringValue(output.ProposalState) == directconnect.GatewayAssociationProposalStateRequested || d.Get("allowed_prefixes").(*schema.Set).Len() == 0 {
+			if err := d.Set("allowed_prefixes", flattenRouteFilterPrefixes(output.RequestedAllowedPrefixesToDirectConnectGateway)); err != nil {
+				return fmt.Errorf("error setting allowed_prefixes: %w", err)
+			}
 		}
 
 		d.Set("associated_gateway_id", output.AssociatedGateway.Id)
@@ -11,11 +11,12 @@ import (
 )
 
 const (
-	connectionConfirmedTimeout     = 10 * time.Minute
-	connectionDeletedTimeout       = 10 * time.Minute
-	connectionDisassociatedTimeout = 1 * time.Minute
-	hostedConnectionDeletedTimeout = 10 * time.Minute
-	lagDeletedTimeout              = 10 * time.Minute
+	connectionConfirmedTimeout                   = 10 * time.Minute
+	connectionDeletedTimeout                     = 10 * time.Minute
+	connectionDisassociatedTimeout               = 1 * time.Minute
+	gatewayAssociationProposalPropagationTimeout = 2 * time.Minute
+	hostedConnectionDeletedTimeout               = 10 * time.Minute
+	lagDeletedTimeout                            = 10 * time.Minute
 )
 
 func waitConnectionConfirmed(conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) {
@@ -77,6 +77,13 @@ func ResourceClusterInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			// DescribeDBInstances does not report the Performance Insights settings,
+			// so these are only ever taken from configuration.
+			"enable_performance_insights": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -122,6 +129,11 @@ func ResourceClusterInstance() *schema.Resource {
 				Required: true,
 			},
 
+			"performance_insights_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -210,6 +222,14 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if attr, ok := d.GetOk("enable_performance_insights"); ok {
+		createOpts.EnablePerformanceInsights = aws.Bool(attr.(bool))
+	}
+
+	if attr, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+		createOpts.PerformanceInsightsKMSKeyId = aws.String(attr.(string))
+	}
+
 	if attr, ok := d.GetOk("preferred_maintenance_window"); ok {
 		createOpts.PreferredMaintenanceWindow = aws.String(attr.(string))
 	}
@@ -377,6 +397,16 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		requestUpdate = true
 	}
 
+	if d.HasChanges("enable_performance_insights", "performance_insights_kms_key_id") {
+		req.EnablePerformanceInsights = aws.Bool(d.Get("enable_performance_insights").(bool))
+
+		if v, ok := d.GetOk("performance_insights_kms_key_id"); ok {
+			req.PerformanceInsightsKMSKeyId = aws.String(v.(string))
+		}
+
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		err := resource.Retry(propagationTimeout, func() *resource.RetryError {
 			_, err := conn.ModifyDBInstance(req)
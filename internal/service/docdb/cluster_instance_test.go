@@ -224,6 +224,47 @@ func TestAccDocDBClusterInstance_disappears(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterInstance_performanceInsights(t *testing.T) {
+	var v docdb.DBInstance
+	resourceName := "aws_docdb_cluster_instance.cluster_instances"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, docdb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_performanceInsights(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_performance_insights", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "performance_insights_kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"enable_performance_insights",
+					"identifier_prefix",
+					"performance_insights_kms_key_id",
+				},
+			},
+			{
+				Config: testAccClusterInstanceConfig_performanceInsights(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_performance_insights", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterInstanceAttributes(v *docdb.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -530,3 +571,34 @@ resource "aws_docdb_cluster_instance" "cluster_instances" {
 }
 `, rName))
 }
+
+func testAccClusterInstanceConfig_performanceInsights(rName string, enabled bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier  = %[1]q
+  availability_zones  = [data.aws_availability_zones.available.names[0], data.aws_availability_zones.available.names[1], data.aws_availability_zones.available.names[2]]
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+data "aws_docdb_orderable_db_instance" "test" {
+  engine                     = "docdb"
+  preferred_instance_classes = ["db.r5.large", "db.r5.xlarge"]
+}
+
+resource "aws_docdb_cluster_instance" "cluster_instances" {
+  identifier                      = %[1]q
+  cluster_identifier              = aws_docdb_cluster.default.id
+  instance_class                  = data.aws_docdb_orderable_db_instance.test.instance_class
+  apply_immediately               = true
+  enable_performance_insights     = %[2]t
+  performance_insights_kms_key_id = aws_kms_key.test.arn
+}
+`, rName, enabled))
+}
@@ -252,22 +252,7 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"enclave_options": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
-						},
-					},
-				},
-			},
+			"enclave_options": enclaveOptionsSchema(true),
 			"ephemeral_block_device": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -711,6 +696,7 @@ func ResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			customizeDiffEnclaveOptions,
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				_, ok := diff.GetOk("launch_template")
 
@@ -2904,6 +2890,115 @@ func expandInstanceMetadataOptions(l []interface{}) *ec2.InstanceMetadataOptions
 	return opts
 }
 
+// enclaveOptionsSchema returns the schema of the enclave_options block shared by instances,
+// Spot Instance requests and launch templates.
+// Running instances always report their enclave options and cannot change them in place,
+// so for those the block is computed and forces replacement.
+func enclaveOptionsSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: forceNew,
+					ForceNew: forceNew,
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffEnclaveOptions checks at plan time that the configured instance type can run Nitro Enclaves.
+// The instance type is only looked up when either argument changes.
+func customizeDiffEnclaveOptions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("enclave_options.0.enabled").(bool) {
+		return nil
+	}
+
+	if !diff.HasChanges("enclave_options", "instance_type") || !diff.NewValueKnown("instance_type") {
+		return nil
+	}
+
+	instanceType := diff.Get("instance_type").(string)
+
+	if instanceType == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceTypeInfo, err := FindInstanceTypeByName(conn, instanceType)
+
+	// The check is best effort. Don't fail the plan if the instance type can't be described,
+	// e.g. because the caller lacks ec2:DescribeInstanceTypes; the launch itself reports unsupported types.
+	if err != nil {
+		log.Printf("[WARN] Unable to verify Nitro Enclaves support for EC2 Instance Type (%s): %s", instanceType, err)
+		return nil
+	}
+
+	if !instanceTypeSupportsNitroEnclaves(instanceTypeInfo) {
+		return fmt.Errorf("EC2 Instance Type (%s) does not support Nitro Enclaves", instanceType)
+	}
+
+	return nil
+}
+
+// instanceTypeFamiliesWithoutNitroEnclaves lists Nitro instance families that meet the
+// attribute-based requirements below but do not support Nitro Enclaves.
+// DescribeInstanceTypes does not report enclave support directly in this SDK version.
+var instanceTypeFamiliesWithoutNitroEnclaves = []string{
+	"a1",
+	"c7i-flex",
+	"m7i-flex",
+}
+
+// instanceTypeSupportsNitroEnclaves reports whether Nitro Enclaves can be enabled on the instance type.
+// Enclaves require a virtualized (not bare metal), non-burstable Nitro instance with at least 4 vCPUs,
+// or 2 vCPUs for AWS Graviton instances.
+func instanceTypeSupportsNitroEnclaves(apiObject *ec2.InstanceTypeInfo) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	if aws.StringValue(apiObject.Hypervisor) != ec2.InstanceTypeHypervisorNitro || aws.BoolValue(apiObject.BareMetal) {
+		return false
+	}
+
+	if aws.BoolValue(apiObject.BurstablePerformanceSupported) {
+		return false
+	}
+
+	family := strings.SplitN(aws.StringValue(apiObject.InstanceType), ".", 2)[0]
+
+	for _, v := range instanceTypeFamiliesWithoutNitroEnclaves {
+		if family == v {
+			return false
+		}
+	}
+
+	if apiObject.VCpuInfo == nil {
+		return false
+	}
+
+	minVCpus := int64(4)
+
+	if apiObject.ProcessorInfo != nil {
+		for _, v := range apiObject.ProcessorInfo.SupportedArchitectures {
+			if aws.StringValue(v) == ec2.ArchitectureTypeArm64 {
+				minVCpus = 2
+				break
+			}
+		}
+	}
+
+	return aws.Int64Value(apiObject.VCpuInfo.DefaultVCpus) >= minVCpus
+}
+
 func expandEnclaveOptions(l []interface{}) *ec2.EnclaveOptionsRequest {
 	if len(l) == 0 || l[0] == nil {
 		return nil
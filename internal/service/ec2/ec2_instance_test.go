@@ -4493,6 +4493,23 @@ func TestAccEC2Instance_enclaveOptions(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_EnclaveOptions_unsupportedInstanceType(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceConfig_enclaveOptionsInstanceType(rName, "t3.micro"),
+				ExpectError: regexp.MustCompile(`does not support Nitro Enclaves`),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_CapacityReservation_unspecifiedDefaultsToOpen(t *testing.T) {
 	var v ec2.Instance
 	resourceName := "aws_instance.test"
@@ -7767,6 +7784,25 @@ resource "aws_instance" "test" {
 `, rName, enabled))
 }
 
+func testAccInstanceConfig_enclaveOptionsInstanceType(rName, instanceType string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = %[2]q
+
+  enclave_options {
+    enabled = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType))
+}
+
 func testAccInstanceConfig_dynamicEBSBlockDevices(rName string) string {
 	return acctest.ConfigCompose(testAccLatestAmazonLinuxPVEBSAMIConfig(), fmt.Sprintf(`
 resource "aws_instance" "test" {
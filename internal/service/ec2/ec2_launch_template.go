@@ -239,19 +239,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
-			"enclave_options": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-					},
-				},
-			},
+			"enclave_options": enclaveOptionsSchema(false),
 			"hibernation_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -946,6 +934,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			customizeDiffEnclaveOptions,
 			verify.SetTagsDiff,
 		),
 	}
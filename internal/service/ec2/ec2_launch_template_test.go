@@ -2782,6 +2782,23 @@ func TestAccEC2LaunchTemplate_enclaveOptions(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_EnclaveOptions_unsupportedInstanceType(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateConfig_enclaveOptionsInstanceType(rName, "t3.micro"),
+				ExpectError: regexp.MustCompile(`does not support Nitro Enclaves`),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_hibernation(t *testing.T) {
 	var template ec2.LaunchTemplate
 	resourceName := "aws_launch_template.test"
@@ -3886,6 +3903,19 @@ resource "aws_launch_template" "test" {
 `, rName, enabled)
 }
 
+func testAccLaunchTemplateConfig_enclaveOptionsInstanceType(rName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+
+  enclave_options {
+    enabled = true
+  }
+}
+`, rName, instanceType)
+}
+
 func testAccLaunchTemplateConfig_hibernation(rName string, enabled bool) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -1,6 +1,8 @@
 package ec2
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
@@ -125,6 +127,16 @@ func ResourceSpotInstanceRequest() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			// Spot Instance request launch specifications have no enclave options.
+			customdiff.ValidateValue("enclave_options", func(_ context.Context, value, meta interface{}) error {
+				if v, ok := value.([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					if tfMap := v[0].(map[string]interface{}); tfMap["enabled"].(bool) {
+						return errors.New("enclave_options cannot be enabled on Spot Instance requests, use a launch template with aws_instance instead")
+					}
+				}
+
+				return nil
+			}),
 		),
 	}
 }
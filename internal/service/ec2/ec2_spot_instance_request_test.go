@@ -2,6 +2,7 @@ package ec2_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -398,6 +399,23 @@ func TestAccEC2SpotInstanceRequest_getPasswordData(t *testing.T) {
 	})
 }
 
+func TestAccEC2SpotInstanceRequest_enclaveOptions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSpotInstanceRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotInstanceRequestConfig_enclaveOptions(rName),
+				ExpectError: regexp.MustCompile(`enclave_options cannot be enabled on Spot Instance requests`),
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotInstanceRequest_interruptStop(t *testing.T) {
 	var sir ec2.SpotInstanceRequest
 	resourceName := "aws_spot_instance_request.test"
@@ -996,6 +1014,26 @@ resource "aws_ec2_tag" "test" {
 `, rName, publicKey))
 }
 
+func testAccSpotInstanceRequestConfig_enclaveOptions(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		fmt.Sprintf(`
+resource "aws_spot_instance_request" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "c5.xlarge"
+  spot_price    = "0.20"
+
+  enclave_options {
+    enabled = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccSpotInstanceRequestConfig_interrupt(rName, interruptionBehavior string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
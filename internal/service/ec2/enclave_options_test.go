@@ -0,0 +1,95 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestInstanceTypeSupportsNitroEnclaves(t *testing.T) {
+	instanceTypeInfo := func(instanceType, hypervisor, architecture string, vCpus int64, burstable, bareMetal bool) *ec2.InstanceTypeInfo {
+		apiObject := &ec2.InstanceTypeInfo{
+			BareMetal:                     aws.Bool(bareMetal),
+			BurstablePerformanceSupported: aws.Bool(burstable),
+			InstanceType:                  aws.String(instanceType),
+			ProcessorInfo: &ec2.ProcessorInfo{
+				SupportedArchitectures: aws.StringSlice([]string{architecture}),
+			},
+			VCpuInfo: &ec2.VCpuInfo{
+				DefaultVCpus: aws.Int64(vCpus),
+			},
+		}
+
+		if hypervisor != "" {
+			apiObject.Hypervisor = aws.String(hypervisor)
+		}
+
+		return apiObject
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    *ec2.InstanceTypeInfo
+		Expected bool
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: false,
+		},
+		{
+			Name:     "m5.xlarge",
+			Input:    instanceTypeInfo("m5.xlarge", ec2.InstanceTypeHypervisorNitro, ec2.ArchitectureTypeX8664, 4, false, false),
+			Expected: true,
+		},
+		{
+			Name:     "m5.large too few vCPUs",
+			Input:    instanceTypeInfo("m5.large", ec2.InstanceTypeHypervisorNitro, ec2.ArchitectureTypeX8664, 2, false, false),
+			Expected: false,
+		},
+		{
+			Name:     "c6g.large Graviton",
+			Input:    instanceTypeInfo("c6g.large", ec2.InstanceTypeHypervisorNitro, ec2.ArchitectureTypeArm64, 2, false, false),
+			Expected: true,
+		},
+		{
+			Name:     "c6g.medium Graviton too few vCPUs",
+			Input:    instanceTypeInfo("c6g.medium", ec2.InstanceTypeHypervisorNitro, ec2.ArchitectureTypeArm64, 1, false, false),
+			Expected: false,
+		},
+		{
+			Name:     "a1.xlarge first generation Graviton",
+			Input:    instanceTypeInfo("a1.xlarge", ec2.InstanceTypeHypervisorNitro, ec2.ArchitectureTypeArm64, 4, false, false),
+			Expected: false,
+		},
+		{
+			Name:     "t3.xlarge burstable",
+			Input:    instanceTypeInfo("t3.xlarge", ec2.InstanceTypeHypervisorNitro, ec2.ArchitectureTypeX8664, 4, true, false),
+			Expected: false,
+		},
+		{
+			Name:     "m4.xlarge Xen",
+			Input:    instanceTypeInfo("m4.xlarge", ec2.InstanceTypeHypervisorXen, ec2.ArchitectureTypeX8664, 4, false, false),
+			Expected: false,
+		},
+		{
+			Name:     "m5.metal bare metal",
+			Input:    instanceTypeInfo("m5.metal", "", ec2.ArchitectureTypeX8664, 96, false, true),
+			Expected: false,
+		},
+		{
+			Name:     "no vCPU info",
+			Input:    &ec2.InstanceTypeInfo{Hypervisor: aws.String(ec2.InstanceTypeHypervisorNitro), InstanceType: aws.String("m5.xlarge")},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := instanceTypeSupportsNitroEnclaves(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -1122,6 +1122,26 @@ func StatusVPCEndpointServiceStateAvailable(conn *ec2.EC2, id string) resource.S
 	}
 }
 
+func StatusVPCEndpointServicePrivateDNSNameConfiguration(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointServiceConfigurationByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PrivateDnsNameConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.PrivateDnsNameConfiguration, aws.StringValue(output.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 func StatusVPCEndpointServiceStateDeleted(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindVPCEndpointServiceConfigurationByID(conn, id)
@@ -1205,3 +1225,19 @@ func StatusSnapshotStorageTier(conn *ec2.EC2, id string) resource.StateRefreshFu
 		return output, aws.StringValue(output.StorageTier), nil
 	}
 }
+
+func statusNetworkInsightsAnalysis(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkInsightsAnalysisByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
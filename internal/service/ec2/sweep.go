@@ -148,9 +148,17 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_ec2_network_insights_analysis", &resource.Sweeper{
+		Name: "aws_ec2_network_insights_analysis",
+		F:    sweepNetworkInsightsAnalyses,
+	})
+
 	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
+		Dependencies: []string{
+			"aws_ec2_network_insights_analysis",
+		},
 	})
 
 	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
@@ -1214,6 +1222,45 @@ func sweepNetworkInterfaces(region string) error {
 	return nil
 }
 
+func sweepNetworkInsightsAnalyses(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeNetworkInsightsAnalysesPages(&ec2.DescribeNetworkInsightsAnalysesInput{}, func(page *ec2.DescribeNetworkInsightsAnalysesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, nia := range page.NetworkInsightsAnalyses {
+			id := aws.StringValue(nia.NetworkInsightsAnalysisId)
+
+			r := ResourceNetworkInsightsAnalysis()
+			d := r.Data(nil)
+
+			d.SetId(id)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Insights Analyses for %s: %w", region, err))
+	}
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Insights Analyses for %s: %w", region, err))
+	}
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Insights Analysis sweep for %s: %s", region, errs)
+		return nil
+	}
+	return errs.ErrorOrNil()
+}
+
 func sweepNetworkInsightsPaths(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -76,6 +76,12 @@ func ResourceVPCEndpointService() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"payer_responsibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.PayerResponsibility_Values(), false),
+			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -224,6 +230,7 @@ func resourceVPCEndpointServiceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("gateway_load_balancer_arns", aws.StringValueSlice(svcCfg.GatewayLoadBalancerArns))
 	d.Set("manages_vpc_endpoints", svcCfg.ManagesVpcEndpoints)
 	d.Set("network_load_balancer_arns", aws.StringValueSlice(svcCfg.NetworkLoadBalancerArns))
+	d.Set("payer_responsibility", svcCfg.PayerResponsibility)
 	d.Set("private_dns_name", svcCfg.PrivateDnsName)
 	// The EC2 API can return a XML structure with no elements.
 	if tfMap := flattenPrivateDNSNameConfiguration(svcCfg.PrivateDnsNameConfiguration); len(tfMap) > 0 {
@@ -309,6 +316,14 @@ func resourceVPCEndpointServiceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("payer_responsibility") {
+		if v, ok := d.GetOk("payer_responsibility"); ok {
+			if err := modifyVPCEndpointServicePayerResponsibility(conn, d.Id(), v.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -347,6 +362,20 @@ func resourceVPCEndpointServiceDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func modifyVPCEndpointServicePayerResponsibility(conn *ec2.EC2, serviceID, payerResponsibility string) error {
+	input := &ec2.ModifyVpcEndpointServicePayerResponsibilityInput{
+		PayerResponsibility: aws.String(payerResponsibility),
+		ServiceId:           aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 VPC Endpoint Service payer responsibility: %s", input)
+	if _, err := conn.ModifyVpcEndpointServicePayerResponsibility(input); err != nil {
+		return fmt.Errorf("modifying EC2 VPC Endpoint Service (%s) payer responsibility: %w", serviceID, err)
+	}
+
+	return nil
+}
+
 func flattenAllowedPrincipal(apiObject *ec2.AllowedPrincipal) *string {
 	if apiObject == nil {
 		return nil
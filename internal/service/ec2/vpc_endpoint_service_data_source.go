@@ -46,6 +46,10 @@ func DataSourceVPCEndpointService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"payer_responsibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -173,6 +177,7 @@ func dataSourceVPCEndpointServiceRead(d *schema.ResourceData, meta interface{})
 	d.Set("base_endpoint_dns_names", aws.StringValueSlice(sd.BaseEndpointDnsNames))
 	d.Set("manages_vpc_endpoints", sd.ManagesVpcEndpoints)
 	d.Set("owner", sd.Owner)
+	d.Set("payer_responsibility", sd.PayerResponsibility)
 	d.Set("private_dns_name", sd.PrivateDnsName)
 	d.Set("service_id", serviceID)
 	d.Set("service_name", serviceName)
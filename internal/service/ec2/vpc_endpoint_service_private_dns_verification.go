@@ -0,0 +1,94 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceVPCEndpointServicePrivateDNSVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEndpointServicePrivateDNSVerificationCreate,
+		Read:   resourceVPCEndpointServicePrivateDNSVerificationRead,
+		Delete: schema.Noop,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"private_dns_name_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_endpoint_service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	serviceID := d.Get("vpc_endpoint_service_id").(string)
+	input := &ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Starting EC2 VPC Endpoint Service private DNS verification: %s", input)
+	_, err := conn.StartVpcEndpointServicePrivateDnsVerification(input)
+
+	if err != nil {
+		return fmt.Errorf("starting EC2 VPC Endpoint Service (%s) private DNS verification: %w", serviceID, err)
+	}
+
+	d.SetId(serviceID)
+
+	if d.Get("wait_for_verification").(bool) {
+		if _, err := WaitVPCEndpointServicePrivateDNSNameVerified(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("waiting for EC2 VPC Endpoint Service (%s) private DNS name verification: %w", d.Id(), err)
+		}
+	}
+
+	return resourceVPCEndpointServicePrivateDNSVerificationRead(d, meta)
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	svcCfg, err := FindVPCEndpointServiceConfigurationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC Endpoint Service %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 VPC Endpoint Service (%s): %w", d.Id(), err)
+	}
+
+	if svcCfg.PrivateDnsNameConfiguration != nil {
+		d.Set("private_dns_name_state", svcCfg.PrivateDnsNameConfiguration.State)
+	} else {
+		d.Set("private_dns_name_state", nil)
+	}
+	d.Set("vpc_endpoint_service_id", svcCfg.ServiceId)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	domainName := acctest.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVPCEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(serviceResourceName, &svcCfg),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_dns_name_state"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCEndpointServicePrivateDNSVerification_waitForVerification(t *testing.T) {
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domainName := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVPCEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_waitForVerification(rName, rootDomain, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(serviceResourceName, &svcCfg),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_endpoint_service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "true"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_state", ec2.DnsNameStateVerified),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, dnsName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_privateDNSName(rName, dnsName), `
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  vpc_endpoint_service_id = aws_vpc_endpoint_service.test.id
+}
+`)
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig_waitForVerification(rName, rootDomain, dnsName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_privateDNSName(rName, dnsName), fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = data.aws_route53_zone.test.zone_id
+  name    = "${aws_vpc_endpoint_service.test.private_dns_name_configuration[0].name}.${aws_vpc_endpoint_service.test.private_dns_name}"
+  type    = aws_vpc_endpoint_service.test.private_dns_name_configuration[0].type
+  ttl     = 60
+  records = [aws_vpc_endpoint_service.test.private_dns_name_configuration[0].value]
+}
+
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  vpc_endpoint_service_id = aws_vpc_endpoint_service.test.id
+  wait_for_verification   = true
+
+  depends_on = [aws_route53_record.test]
+}
+`, rootDomain))
+}
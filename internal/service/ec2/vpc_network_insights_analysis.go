@@ -0,0 +1,415 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkInsightsAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInsightsAnalysisCreate,
+		ReadWithoutTimeout:   resourceNetworkInsightsAnalysisRead,
+		UpdateWithoutTimeout: resourceNetworkInsightsAnalysisUpdate,
+		DeleteWithoutTimeout: resourceNetworkInsightsAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alternate_path_hints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"filter_in_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"forward_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"network_insights_path_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path_found": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"return_path_components": networkInsightsAnalysisPathComponentsSchema(),
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func networkInsightsAnalysisPathComponentsSchema() *schema.Schema {
+	analysisComponentSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	portRangeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"to": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	packetHeaderSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"destination_port_ranges": portRangeSchema,
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"source_addresses": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"source_port_ranges": portRangeSchema,
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attached_to":     analysisComponentSchema,
+				"component":       analysisComponentSchema,
+				"destination_vpc": analysisComponentSchema,
+				"inbound_header":  packetHeaderSchema,
+				"outbound_header": packetHeaderSchema,
+				"sequence_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"source_vpc":      analysisComponentSchema,
+				"subnet":          analysisComponentSchema,
+				"transit_gateway": analysisComponentSchema,
+				"vpc":             analysisComponentSchema,
+			},
+		},
+	}
+}
+
+func resourceNetworkInsightsAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(d.Get("network_insights_path_id").(string)),
+		TagSpecifications:     tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeNetworkInsightsAnalysis),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.FilterInArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Starting EC2 Network Insights Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAnalysisWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error starting EC2 Network Insights Analysis: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitNetworkInsightsAnalysisCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error waiting for EC2 Network Insights Analysis (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindNetworkInsightsAnalysisByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Analysis %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Network Insights Analysis (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("alternate_path_hints", flattenAlternatePathHints(output.AlternatePathHints)); err != nil {
+		return diag.Errorf("error setting alternate_path_hints: %s", err)
+	}
+	d.Set("arn", output.NetworkInsightsAnalysisArn)
+	d.Set("filter_in_arns", aws.StringValueSlice(output.FilterInArns))
+	if err := d.Set("forward_path_components", flattenPathComponents(output.ForwardPathComponents)); err != nil {
+		return diag.Errorf("error setting forward_path_components: %s", err)
+	}
+	d.Set("network_insights_path_id", output.NetworkInsightsPathId)
+	d.Set("path_found", output.NetworkPathFound)
+	if err := d.Set("return_path_components", flattenPathComponents(output.ReturnPathComponents)); err != nil {
+		return diag.Errorf("error setting return_path_components: %s", err)
+	}
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInsightsAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating EC2 Network Insights Analysis (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkInsightsAnalysisRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAnalysisWithContext(ctx, &ec2.DeleteNetworkInsightsAnalysisInput{
+		NetworkInsightsAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting EC2 Network Insights Analysis (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenAlternatePathHints(apiObjects []*ec2.AlternatePathHint) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"component_arn": aws.StringValue(apiObject.ComponentArn),
+			"component_id":  aws.StringValue(apiObject.ComponentId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAnalysisComponent(apiObject *ec2.AnalysisComponent) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":  aws.StringValue(apiObject.Arn),
+		"id":   aws.StringValue(apiObject.Id),
+		"name": aws.StringValue(apiObject.Name),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisPacketHeader(apiObject *ec2.AnalysisPacketHeader) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination_addresses":   aws.StringValueSlice(apiObject.DestinationAddresses),
+		"destination_port_ranges": flattenAnalysisPortRanges(apiObject.DestinationPortRanges),
+		"protocol":                aws.StringValue(apiObject.Protocol),
+		"source_addresses":        aws.StringValueSlice(apiObject.SourceAddresses),
+		"source_port_ranges":      flattenAnalysisPortRanges(apiObject.SourcePortRanges),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAnalysisPortRanges(apiObjects []*ec2.PortRange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"from": aws.Int64Value(apiObject.From),
+			"to":   aws.Int64Value(apiObject.To),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPathComponents(apiObjects []*ec2.PathComponent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attached_to":     flattenAnalysisComponent(apiObject.AttachedTo),
+			"component":       flattenAnalysisComponent(apiObject.Component),
+			"destination_vpc": flattenAnalysisComponent(apiObject.DestinationVpc),
+			"inbound_header":  flattenAnalysisPacketHeader(apiObject.InboundHeader),
+			"outbound_header": flattenAnalysisPacketHeader(apiObject.OutboundHeader),
+			"sequence_number": aws.Int64Value(apiObject.SequenceNumber),
+			"source_vpc":      flattenAnalysisComponent(apiObject.SourceVpc),
+			"subnet":          flattenAnalysisComponent(apiObject.Subnet),
+			"transit_gateway": flattenAnalysisComponent(apiObject.TransitGateway),
+			"vpc":             flattenAnalysisComponent(apiObject.Vpc),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,173 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkInsightsAnalysis_basic(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, nil),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`network-insights-analysis/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "filter_in_arns.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_path_id", "aws_ec2_network_insights_path.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "forward_path_components.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "forward_path_components.0.component.0.id", "aws_network_interface.test_source", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AnalysisStatusSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAnalysis_triggers(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var id1, id2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &id1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, &id2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					func(s *terraform.State) error {
+						if id1 == id2 {
+							return fmt.Errorf("EC2 Network Insights Analysis (%s) was not re-run", id1)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAnalysis_disappears(t *testing.T) {
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckNetworkInsightsAnalysisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(resourceName, nil),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceNetworkInsightsAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAnalysisExists(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Analysis ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		_, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if id != nil {
+			*id = rs.Primary.ID
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkInsightsAnalysisDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_network_insights_analysis" {
+			continue
+		}
+
+		_, err := tfec2.FindNetworkInsightsAnalysisByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Network Insights Analysis %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, run string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsPathConfig_basic(rName, "tcp"), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  triggers = {
+    run = %[1]q
+  }
+}
+`, run))
+}
@@ -0,0 +1,64 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTrafficMirrorFilters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTrafficMirrorFiltersRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceTrafficMirrorFiltersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeTrafficMirrorFiltersInput{}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filters = append(input.Filters, BuildTagFilterList(
+			Tags(tftags.New(tags.(map[string]interface{}))),
+		)...)
+	}
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindTrafficMirrorFilters(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filters: %w", err)
+	}
+
+	var filterIDs []string
+
+	for _, v := range output {
+		filterIDs = append(filterIDs, aws.StringValue(v.TrafficMirrorFilterId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", filterIDs)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCTrafficMirrorFiltersDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilter(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(testAccVPCTrafficMirrorFilterConfig_tags1("Name", rName), `
+data "aws_ec2_traffic_mirror_filters" "by_tags" {
+  tags = {
+    Name = aws_ec2_traffic_mirror_filter.test.tags["Name"]
+  }
+}
+
+data "aws_ec2_traffic_mirror_filters" "by_filter" {
+  filter {
+    name   = "traffic-mirror-filter-id"
+    values = [aws_ec2_traffic_mirror_filter.test.id]
+  }
+}
+
+data "aws_ec2_traffic_mirror_filters" "empty" {
+  filter {
+    name   = "traffic-mirror-filter-id"
+    values = ["tmf-00000000000000000"]
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_filters.by_tags", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_traffic_mirror_filters.by_filter", "ids.0", "aws_ec2_traffic_mirror_filter.test", "id"),
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_filters.empty", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
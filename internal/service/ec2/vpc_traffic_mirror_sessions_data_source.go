@@ -0,0 +1,64 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTrafficMirrorSessions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTrafficMirrorSessionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceTrafficMirrorSessionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeTrafficMirrorSessionsInput{}
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filters = append(input.Filters, BuildTagFilterList(
+			Tags(tftags.New(tags.(map[string]interface{}))),
+		)...)
+	}
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindTrafficMirrorSessions(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Sessions: %w", err)
+	}
+
+	var sessionIDs []string
+
+	for _, v := range output {
+		sessionIDs = append(sessionIDs, aws.StringValue(v.TrafficMirrorSessionId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", sessionIDs)
+
+	return nil
+}
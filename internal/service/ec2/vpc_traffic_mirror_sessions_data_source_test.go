@@ -0,0 +1,48 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCTrafficMirrorSessionsDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	session := sdkacctest.RandIntRange(1, 32766)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorSession(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(testAccVPCTrafficMirrorSessionConfig_tags1(rName, "Name", rName, session), `
+data "aws_ec2_traffic_mirror_sessions" "by_tags" {
+  tags = {
+    Name = aws_ec2_traffic_mirror_session.test.tags["Name"]
+  }
+}
+
+data "aws_ec2_traffic_mirror_sessions" "by_filter" {
+  filter {
+    name   = "traffic-mirror-target-id"
+    values = [aws_ec2_traffic_mirror_session.test.traffic_mirror_target_id]
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_sessions.by_tags", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_ec2_traffic_mirror_sessions.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_traffic_mirror_sessions.by_filter", "ids.0", "aws_ec2_traffic_mirror_session.test", "id"),
+				),
+			},
+		},
+	})
+}
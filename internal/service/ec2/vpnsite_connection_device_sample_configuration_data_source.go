@@ -0,0 +1,129 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceVPNConnectionDeviceSampleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPNConnectionDeviceSampleConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"internet_key_exchange_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ikev1", "ikev2"}, false),
+			},
+			"platform": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"sample_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"software": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"vpn_connection_device_type_id"},
+			},
+			"vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"vendor", "vpn_connection_device_type_id"},
+			},
+			"vpn_connection_device_type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"vendor", "vpn_connection_device_type_id"},
+			},
+			"vpn_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPNConnectionDeviceSampleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	deviceTypes, err := FindVPNConnectionDeviceTypes(conn, &ec2.GetVpnConnectionDeviceTypesInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPN Connection Device Types: %w", err)
+	}
+
+	var matches []*ec2.VpnConnectionDeviceType
+
+	for _, v := range deviceTypes {
+		if id, ok := d.GetOk("vpn_connection_device_type_id"); ok {
+			if aws.StringValue(v.VpnConnectionDeviceTypeId) == id.(string) {
+				matches = append(matches, v)
+			}
+
+			continue
+		}
+
+		if aws.StringValue(v.Vendor) != d.Get("vendor").(string) {
+			continue
+		}
+
+		if platform, ok := d.GetOk("platform"); ok && aws.StringValue(v.Platform) != platform.(string) {
+			continue
+		}
+
+		if software, ok := d.GetOk("software"); ok && aws.StringValue(v.Software) != software.(string) {
+			continue
+		}
+
+		matches = append(matches, v)
+	}
+
+	if len(matches) == 0 {
+		return tfresource.SingularDataSourceFindError("EC2 VPN Connection Device Type", tfresource.NewEmptyResultError(nil))
+	}
+
+	if count := len(matches); count > 1 {
+		return tfresource.SingularDataSourceFindError("EC2 VPN Connection Device Type", tfresource.NewTooManyResultsError(count, nil))
+	}
+
+	deviceType := matches[0]
+	vpnConnectionID := d.Get("vpn_connection_id").(string)
+	input := &ec2.GetVpnConnectionDeviceSampleConfigurationInput{
+		VpnConnectionDeviceTypeId: deviceType.VpnConnectionDeviceTypeId,
+		VpnConnectionId:           aws.String(vpnConnectionID),
+	}
+
+	if v, ok := d.GetOk("internet_key_exchange_version"); ok {
+		input.InternetKeyExchangeVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.GetVpnConnectionDeviceSampleConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 VPN Connection (%s) device sample configuration: %w", vpnConnectionID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", vpnConnectionID, aws.StringValue(deviceType.VpnConnectionDeviceTypeId)))
+	d.Set("platform", deviceType.Platform)
+	d.Set("sample_configuration", output.VpnConnectionDeviceSampleConfiguration)
+	d.Set("software", deviceType.Software)
+	d.Set("vendor", deviceType.Vendor)
+	d.Set("vpn_connection_device_type_id", deviceType.VpnConnectionDeviceTypeId)
+
+	return nil
+}
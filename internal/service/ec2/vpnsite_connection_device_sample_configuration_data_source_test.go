@@ -0,0 +1,47 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSiteVPNConnectionDeviceSampleConfigurationDataSource_vendor(t *testing.T) {
+	dataSourceName := "data.aws_vpn_connection_device_sample_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_vendor(rName, rBgpAsn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "platform", "Generic"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sample_configuration"),
+					resource.TestCheckResourceAttr(dataSourceName, "software", "Vendor Agnostic"),
+					resource.TestCheckResourceAttr(dataSourceName, "vendor", "Generic"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpn_connection_device_type_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpn_connection_id", "aws_vpn_connection.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteVPNConnectionDeviceSampleConfigurationDataSourceConfig_vendor(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(testAccSiteVPNConnectionConfig_basic(rName, rBgpAsn), `
+data "aws_vpn_connection_device_sample_configuration" "test" {
+  vpn_connection_id             = aws_vpn_connection.test.id
+  vendor                        = "Generic"
+  platform                      = "Generic"
+  software                      = "Vendor Agnostic"
+  internet_key_exchange_version = "ikev2"
+}
+`)
+}
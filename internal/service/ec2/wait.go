@@ -2300,6 +2300,25 @@ func WaitVPCEndpointServiceAvailable(conn *ec2.EC2, id string, timeout time.Dura
 	return nil, err
 }
 
+func WaitVPCEndpointServicePrivateDNSNameVerified(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.PrivateDnsNameConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.DnsNameStatePendingVerification},
+		Target:     []string{ec2.DnsNameStateVerified},
+		Refresh:    StatusVPCEndpointServicePrivateDNSNameConfiguration(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.PrivateDnsNameConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitVPCEndpointServiceDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ServiceConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
@@ -2415,3 +2434,25 @@ func waitEBSSnapshotTierArchive(conn *ec2.EC2, id string, timeout time.Duration)
 
 	return nil, err
 }
+
+func waitNetworkInsightsAnalysisCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.NetworkInsightsAnalysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AnalysisStatusRunning},
+		Target:  []string{ec2.AnalysisStatusSucceeded},
+		Refresh: statusNetworkInsightsAnalysis(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.NetworkInsightsAnalysis); ok {
+		if status := aws.StringValue(output.Status); status == ec2.AnalysisStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceReplicationConfiguration() *schema.Resource {
@@ -164,13 +165,14 @@ func resourceReplicationConfigurationDelete(d *schema.ResourceData, meta interfa
 	// Deletion of the replication configuration must be done from the
 	// Region in which the destination file system is located.
 	destination := expandDestinationsToCreate(d.Get("destination").([]interface{}))[0]
-	session, err := conns.NewSessionForRegion(&conn.Config, aws.StringValue(destination.Region), meta.(*conns.AWSClient).TerraformVersion)
+	region := aws.StringValue(destination.Region)
+	v, err := meta.(*conns.AWSClient).RegionalConn(names.EFS, region)
 
 	if err != nil {
-		return fmt.Errorf("creating AWS session: %w", err)
+		return fmt.Errorf("creating EFS client for Region (%s): %w", region, err)
 	}
 
-	deleteConn := efs.New(session)
+	deleteConn := v.(*efs.EFS)
 
 	log.Printf("[DEBUG] Deleting EFS Replication Configuration: %s", d.Id())
 	_, err = deleteConn.DeleteReplicationConfiguration(&efs.DeleteReplicationConfigurationInput{
@@ -0,0 +1,75 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	gversion "github.com/hashicorp/go-version"
+)
+
+func validAddonVersionConstraint(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := gversion.NewConstraint(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid version constraint: %w", k, err))
+	}
+
+	return
+}
+
+// addonVersionMatchingConstraint returns the greatest of the specified add-on versions that satisfies the
+// version constraint, or nil if none does.
+// Add-on versions carry an EKS build suffix (e.g. v1.11.2-eksbuild.1) that semantic versioning treats as a
+// pre-release, so the constraint is checked against the version's major, minor and patch segments only.
+func addonVersionMatchingConstraint(versions []*eks.AddonVersionInfo, constraint string) (*eks.AddonVersionInfo, error) {
+	c, err := gversion.NewConstraint(constraint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var match *eks.AddonVersionInfo
+	var matchVersion *gversion.Version
+
+	for _, v := range versions {
+		version, err := gversion.NewVersion(aws.StringValue(v.AddonVersion))
+
+		if err != nil {
+			continue
+		}
+
+		if !c.Check(version.Core()) {
+			continue
+		}
+
+		if matchVersion == nil || version.GreaterThan(matchVersion) {
+			match = v
+			matchVersion = version
+		}
+	}
+
+	return match, nil
+}
+
+// addonVersionIsDefault returns whether the add-on version is the default for the specified Kubernetes version.
+func addonVersionIsDefault(v *eks.AddonVersionInfo, kubernetesVersion string) bool {
+	for _, compatibility := range v.Compatibilities {
+		if aws.StringValue(compatibility.ClusterVersion) == kubernetesVersion && aws.BoolValue(compatibility.DefaultVersion) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenAddonVersions(versions []*eks.AddonVersionInfo, kubernetesVersion string) []interface{} {
+	tfList := make([]interface{}, 0, len(versions))
+
+	for _, v := range versions {
+		tfList = append(tfList, map[string]interface{}{
+			"default": addonVersionIsDefault(v, kubernetesVersion),
+			"version": aws.StringValue(v.AddonVersion),
+		})
+	}
+
+	return tfList
+}
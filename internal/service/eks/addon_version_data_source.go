@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,13 +26,36 @@ func DataSourceAddonVersion() *schema.Resource {
 				Required: true,
 			},
 			"most_recent": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"version_constraint"},
 			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"version_constraint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"most_recent"},
+				ValidateFunc:  validAddonVersionConstraint,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -44,12 +68,40 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 	mostRecent := d.Get("most_recent").(bool)
 	id := addonName
 
-	versionInfo, err := FindAddonVersionByAddonNameAndKubernetesVersion(ctx, conn, id, kubernetesVersion, mostRecent)
+	versions, err := FindAddonVersionsByAddonNameAndKubernetesVersion(ctx, conn, id, kubernetesVersion)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error reading EKS Add-On version info (%s, %s): %w", id, kubernetesVersion, err))
 	}
 
+	var versionInfo *eks.AddonVersionInfo
+
+	if v, ok := d.GetOk("version_constraint"); ok {
+		constraint := v.(string)
+		versionInfo, err = addonVersionMatchingConstraint(versions, constraint)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if versionInfo == nil {
+			return diag.Errorf("no EKS Add-On (%s) version compatible with Kubernetes version %s matches constraint %q", id, kubernetesVersion, constraint)
+		}
+	} else if mostRecent {
+		versionInfo = versions[0]
+	} else {
+		for _, v := range versions {
+			if addonVersionIsDefault(v, kubernetesVersion) {
+				versionInfo = v
+				break
+			}
+		}
+
+		if versionInfo == nil {
+			return diag.Errorf("no default EKS Add-On (%s) version found for Kubernetes version %s", id, kubernetesVersion)
+		}
+	}
+
 	d.SetId(id)
 
 	d.Set("addon_name", addonName)
@@ -57,5 +109,9 @@ func dataSourceAddonVersionRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("most_recent", mostRecent)
 	d.Set("version", versionInfo.AddonVersion)
 
+	if err := d.Set("versions", flattenAddonVersions(versions, kubernetesVersion)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting versions: %w", err))
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package eks_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/eks"
@@ -32,6 +33,7 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "version", addonDataSourceName, "addon_version"),
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "addon_name", addonDataSourceName, "addon_name"),
 					resource.TestCheckResourceAttr(versionDataSourceName, "most_recent", "true"),
+					resource.TestCheckResourceAttrPair(versionDataSourceName, "version", versionDataSourceName, "versions.0.version"),
 				),
 			},
 			{
@@ -41,12 +43,39 @@ func TestAccEKSAddonVersionDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "version", addonDataSourceName, "addon_version"),
 					resource.TestCheckResourceAttrPair(versionDataSourceName, "addon_name", addonDataSourceName, "addon_name"),
 					resource.TestCheckResourceAttr(versionDataSourceName, "most_recent", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs(versionDataSourceName, "versions.*", map[string]string{
+						"default": "true",
+					}),
 				),
 			},
 		},
 	})
 }
 
+func TestAccEKSAddonVersionDataSource_versionConstraint(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_addon_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t); testAccPreCheckAddon(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonVersionDataSourceConfig_versionConstraint(rName, "vpc-cni", "~> 1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "version", regexp.MustCompile(`^v1\.`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.#"),
+				),
+			},
+			{
+				Config:      testAccAddonVersionDataSourceConfig_versionConstraint(rName, "vpc-cni", "< 0.1"),
+				ExpectError: regexp.MustCompile(`matches constraint`),
+			},
+		},
+	})
+}
+
 func testAccAddonVersionDataSourceConfig_basic(rName, addonName string, mostRecent bool) string {
 	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
 data "aws_eks_addon_version" "test" {
@@ -75,3 +104,13 @@ data "aws_eks_addon" "test" {
 }
 `, rName, addonName, mostRecent))
 }
+
+func testAccAddonVersionDataSourceConfig_versionConstraint(rName, addonName, constraint string) string {
+	return acctest.ConfigCompose(testAccAddonBaseConfig(rName), fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[2]q
+  kubernetes_version = aws_eks_cluster.test.version
+  version_constraint = %[3]q
+}
+`, rName, addonName, constraint))
+}
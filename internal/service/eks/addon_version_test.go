@@ -0,0 +1,107 @@
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+)
+
+func TestAddonVersionMatchingConstraint(t *testing.T) {
+	versions := []*eks.AddonVersionInfo{
+		{AddonVersion: aws.String("v1.12.0-eksbuild.1")},
+		{AddonVersion: aws.String("v1.11.4-eksbuild.1")},
+		{AddonVersion: aws.String("v1.11.2-eksbuild.3")},
+		{AddonVersion: aws.String("v1.11.2-eksbuild.1")},
+		{AddonVersion: aws.String("v1.10.4-eksbuild.1")},
+		{AddonVersion: aws.String("not-a-version")},
+	}
+
+	testCases := []struct {
+		constraint string
+		expected   string
+	}{
+		{
+			constraint: "~> 1.11.0",
+			expected:   "v1.11.4-eksbuild.1",
+		},
+		{
+			constraint: ">= 1.10, < 1.12",
+			expected:   "v1.11.4-eksbuild.1",
+		},
+		{
+			constraint: "1.11.2",
+			expected:   "v1.11.2-eksbuild.3",
+		},
+		{
+			constraint: "~> 1.10",
+			expected:   "v1.12.0-eksbuild.1",
+		},
+		{
+			constraint: "< 1.10",
+			expected:   "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.constraint, func(t *testing.T) {
+			got, err := addonVersionMatchingConstraint(versions, testCase.constraint)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expected == "" {
+				if got != nil {
+					t.Errorf("got %q, want no match", aws.StringValue(got.AddonVersion))
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("got no match, want %q", testCase.expected)
+			}
+
+			if got, want := aws.StringValue(got.AddonVersion), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAddonVersionIsDefault(t *testing.T) {
+	v := &eks.AddonVersionInfo{
+		AddonVersion: aws.String("v1.11.2-eksbuild.1"),
+		Compatibilities: []*eks.Compatibility{
+			{ClusterVersion: aws.String("1.22"), DefaultVersion: aws.Bool(true)},
+			{ClusterVersion: aws.String("1.21"), DefaultVersion: aws.Bool(false)},
+		},
+	}
+
+	if !addonVersionIsDefault(v, "1.22") {
+		t.Errorf("expected default for Kubernetes version 1.22")
+	}
+
+	if addonVersionIsDefault(v, "1.21") {
+		t.Errorf("expected not default for Kubernetes version 1.21")
+	}
+
+	if addonVersionIsDefault(v, "1.20") {
+		t.Errorf("expected not default for Kubernetes version 1.20")
+	}
+}
+
+func TestValidAddonVersionConstraint(t *testing.T) {
+	for _, v := range []string{"~> 1.11.0", ">= 1.10, < 1.12", "1.11.2"} {
+		if _, errors := validAddonVersionConstraint(v, "version_constraint"); len(errors) != 0 {
+			t.Errorf("%q should be a valid version constraint: %v", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "latest", "~>"} {
+		if _, errors := validAddonVersionConstraint(v, "version_constraint"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid version constraint", v)
+		}
+	}
+}
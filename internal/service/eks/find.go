@@ -68,33 +68,29 @@ func FindAddonUpdateByClusterNameAddonNameAndID(ctx context.Context, conn *eks.E
 	return output.Update, nil
 }
 
-func FindAddonVersionByAddonNameAndKubernetesVersion(ctx context.Context, conn *eks.EKS, addonName, kubernetesVersion string, mostRecent bool) (*eks.AddonVersionInfo, error) {
+// FindAddonVersionsByAddonNameAndKubernetesVersion returns all versions of the specified add-on
+// that are compatible with the specified Kubernetes version, most recent first.
+func FindAddonVersionsByAddonNameAndKubernetesVersion(ctx context.Context, conn *eks.EKS, addonName, kubernetesVersion string) ([]*eks.AddonVersionInfo, error) {
 	input := &eks.DescribeAddonVersionsInput{
 		AddonName:         aws.String(addonName),
 		KubernetesVersion: aws.String(kubernetesVersion),
 	}
-	var version *eks.AddonVersionInfo
+	var output []*eks.AddonVersionInfo
 
 	err := conn.DescribeAddonVersionsPagesWithContext(ctx, input, func(page *eks.DescribeAddonVersionsOutput, lastPage bool) bool {
-		if page == nil || len(page.Addons) == 0 {
+		if page == nil {
 			return !lastPage
 		}
 
 		for _, addon := range page.Addons {
-			for i, addonVersion := range addon.AddonVersions {
-				if mostRecent && i == 0 {
-					version = addonVersion
-					return !lastPage
-				}
-				for _, versionCompatibility := range addonVersion.Compatibilities {
-					if aws.BoolValue(versionCompatibility.DefaultVersion) {
-						version = addonVersion
-						return !lastPage
-					}
-				}
+			if aws.StringValue(addon.AddonName) != addonName {
+				continue
 			}
+
+			output = append(output, addon.AddonVersions...)
 		}
-		return lastPage
+
+		return !lastPage
 	})
 
 	if tfawserr.ErrCodeEquals(err, eks.ErrCodeResourceNotFoundException) {
@@ -108,14 +104,14 @@ func FindAddonVersionByAddonNameAndKubernetesVersion(ctx context.Context, conn *
 		return nil, err
 	}
 
-	if version == nil || version.AddonVersion == nil {
+	if len(output) == 0 {
 		return nil, &resource.NotFoundError{
 			Message:     "Empty result",
 			LastRequest: input,
 		}
 	}
 
-	return version, nil
+	return output, nil
 }
 
 func FindClusterByName(conn *eks.EKS, name string) (*eks.Cluster, error) {
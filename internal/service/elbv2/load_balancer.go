@@ -36,6 +36,7 @@ func ResourceLoadBalancer() *schema.Resource {
 		// Subnets are ForceNew for Network Load Balancers
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffNLBSubnets,
+			customizeDiffLogsBucketPolicy,
 			verify.SetTagsDiff,
 		),
 		Importer: &schema.ResourceImporter{
@@ -186,6 +187,36 @@ func ResourceLoadBalancer() *schema.Resource {
 				},
 			},
 
+			"connection_logs": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("connection_logs.0.enabled").(bool)
+							},
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("connection_logs.0.enabled").(bool)
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"enable_deletion_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -509,6 +540,38 @@ func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error
 			})
 		}
 
+		if d.HasChange("connection_logs") {
+			logs := d.Get("connection_logs").([]interface{})
+
+			if len(logs) == 1 && logs[0] != nil {
+				log := logs[0].(map[string]interface{})
+
+				enabled := log["enabled"].(bool)
+
+				attributes = append(attributes,
+					&elbv2.LoadBalancerAttribute{
+						Key:   aws.String("connection_logs.s3.enabled"),
+						Value: aws.String(strconv.FormatBool(enabled)),
+					})
+				if enabled {
+					attributes = append(attributes,
+						&elbv2.LoadBalancerAttribute{
+							Key:   aws.String("connection_logs.s3.bucket"),
+							Value: aws.String(log["bucket"].(string)),
+						},
+						&elbv2.LoadBalancerAttribute{
+							Key:   aws.String("connection_logs.s3.prefix"),
+							Value: aws.String(log["prefix"].(string)),
+						})
+				}
+			} else {
+				attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+					Key:   aws.String("connection_logs.s3.enabled"),
+					Value: aws.String("false"),
+				})
+			}
+		}
+
 	case elbv2.LoadBalancerTypeEnumGateway, elbv2.LoadBalancerTypeEnumNetwork:
 		if d.HasChange("enable_cross_zone_load_balancing") || d.IsNewResource() {
 			attributes = append(attributes, &elbv2.LoadBalancerAttribute{
@@ -865,6 +928,11 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 		"enabled": false,
 		"prefix":  "",
 	}
+	connectionLogMap := map[string]interface{}{
+		"bucket":  "",
+		"enabled": false,
+		"prefix":  "",
+	}
 
 	for _, attr := range attributesResp.Attributes {
 		switch aws.StringValue(attr.Key) {
@@ -874,6 +942,12 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 			accessLogMap["bucket"] = aws.StringValue(attr.Value)
 		case "access_logs.s3.prefix":
 			accessLogMap["prefix"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.enabled":
+			connectionLogMap["enabled"] = aws.StringValue(attr.Value) == "true"
+		case "connection_logs.s3.bucket":
+			connectionLogMap["bucket"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.prefix":
+			connectionLogMap["prefix"] = aws.StringValue(attr.Value)
 		case "idle_timeout.timeout_seconds":
 			timeout, err := strconv.Atoi(aws.StringValue(attr.Value))
 			if err != nil {
@@ -912,6 +986,10 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 		return fmt.Errorf("error setting access_logs: %w", err)
 	}
 
+	if err := d.Set("connection_logs", []interface{}{connectionLogMap}); err != nil {
+		return fmt.Errorf("error setting connection_logs: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(conn.PartitionID, err) {
@@ -0,0 +1,223 @@
+package elbv2
+
+import ( // nosemgrep: aws-sdk-go-multiple-service-imports
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+const (
+	// Newer Regions deliver logs using a service principal rather than a regional account.
+	logDeliveryServicePrincipal = "logdelivery.elasticloadbalancing.amazonaws.com"
+	// Network Load Balancer access logs are delivered by CloudWatch Logs.
+	nlbLogDeliveryServicePrincipal = "delivery.logs.amazonaws.com"
+)
+
+// customizeDiffLogsBucketPolicy verifies at plan time that the bucket policies of the
+// access_logs and connection_logs buckets allow log delivery to write objects.
+// Load balancers accept a misconfigured bucket and then silently drop logs.
+// Each check only runs when its block changes and the bucket name is known, and is skipped
+// if the bucket has no policy yet or the policy cannot be read or parsed, e.g. for buckets owned
+// by another account or whose policy is created in the same apply.
+func customizeDiffLogsBucketPolicy(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+
+	switch diff.Get("load_balancer_type").(string) {
+	case elbv2.LoadBalancerTypeEnumApplication:
+		principals := elbLogDeliveryPrincipals(client)
+
+		if err := checkLogsBucketPolicy(diff, client, "access_logs", principals); err != nil {
+			return err
+		}
+
+		return checkLogsBucketPolicy(diff, client, "connection_logs", principals)
+	case elbv2.LoadBalancerTypeEnumNetwork:
+		return checkLogsBucketPolicy(diff, client, "access_logs", []string{nlbLogDeliveryServicePrincipal})
+	}
+
+	return nil
+}
+
+func checkLogsBucketPolicy(diff *schema.ResourceDiff, client *conns.AWSClient, key string, principals []string) error {
+	if !diff.HasChange(key) || !diff.NewValueKnown(key+".0.bucket") {
+		return nil
+	}
+
+	logs := diff.Get(key).([]interface{})
+
+	if len(logs) != 1 || logs[0] == nil {
+		return nil
+	}
+
+	tfMap := logs[0].(map[string]interface{})
+
+	if !tfMap["enabled"].(bool) || tfMap["bucket"].(string) == "" {
+		return nil
+	}
+
+	if err := checkLogDeliveryBucketPolicy(client, tfMap["bucket"].(string), principals); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	return nil
+}
+
+// elbLogDeliveryPrincipals returns the principals that Elastic Load Balancing uses to deliver
+// Application Load Balancer logs in the current Region.
+func elbLogDeliveryPrincipals(client *conns.AWSClient) []string {
+	principals := []string{logDeliveryServicePrincipal}
+
+	if accountID, ok := tfelb.AccountIdPerRegionMap[client.Region]; ok {
+		principals = append(principals, accountID, arn.ARN{
+			Partition: client.Partition,
+			Service:   "iam",
+			AccountID: accountID,
+			Resource:  "root",
+		}.String())
+	}
+
+	return principals
+}
+
+// checkLogDeliveryBucketPolicy verifies that the bucket policy of the specified S3 bucket allows
+// any of the specified log delivery principals to write objects.
+func checkLogDeliveryBucketPolicy(client *conns.AWSClient, bucket string, principals []string) error {
+	output, err := client.S3Conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Unable to verify log delivery permissions for S3 Bucket (%s): %s", bucket, err)
+		return nil
+	}
+
+	ok, err := bucketPolicyAllowsLogDelivery(aws.StringValue(output.Policy), principals)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to verify log delivery permissions for S3 Bucket (%s): parsing policy: %s", bucket, err)
+		return nil
+	}
+
+	if !ok {
+		return fmt.Errorf("S3 Bucket (%s) policy does not allow s3:PutObject for any of %s", bucket, strings.Join(principals, ", "))
+	}
+
+	return nil
+}
+
+type logDeliveryPolicyDoc struct {
+	Statement json.RawMessage `json:"Statement"`
+}
+
+type logDeliveryPolicyStatement struct {
+	Effect     string                               `json:"Effect"`
+	Actions    interface{}                          `json:"Action"`
+	Principals tfiam.IAMPolicyStatementPrincipalSet `json:"Principal"`
+}
+
+// bucketPolicyAllowsLogDelivery returns whether the policy document contains an Allow statement
+// granting s3:PutObject to any of the specified principals.
+// Statement may be a single object or an array, and actions may contain IAM wildcards.
+func bucketPolicyAllowsLogDelivery(policy string, principals []string) (bool, error) {
+	var doc logDeliveryPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, err
+	}
+
+	var statements []*logDeliveryPolicyStatement
+
+	if raw := bytes.TrimSpace(doc.Statement); len(raw) > 0 && raw[0] == '{' {
+		var statement logDeliveryPolicyStatement
+
+		if err := json.Unmarshal(raw, &statement); err != nil {
+			return false, err
+		}
+
+		statements = append(statements, &statement)
+	} else if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &statements); err != nil {
+			return false, err
+		}
+	}
+
+	for _, statement := range statements {
+		if statement == nil || statement.Effect != "Allow" {
+			continue
+		}
+
+		if !policyValuesMatch(statement.Actions, func(v string) bool {
+			return policyActionMatches(v, "s3:PutObject")
+		}) {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			if policyValuesMatch(principal.Identifiers, func(v string) bool {
+				if v == "*" {
+					return true
+				}
+				for _, p := range principals {
+					if v == p {
+						return true
+					}
+				}
+				return false
+			}) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// policyActionMatches returns whether an IAM policy action, which may contain the * and ? wildcards,
+// matches the specified action. Action names are case-insensitive.
+func policyActionMatches(pattern, action string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, `.*`)
+	expr = strings.ReplaceAll(expr, `\?`, `.`)
+
+	re, err := regexp.Compile(`(?i)^` + expr + `$`)
+
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(action)
+}
+
+func policyValuesMatch(values interface{}, match func(string) bool) bool {
+	switch v := values.(type) {
+	case string:
+		return match(v)
+	case []string:
+		for _, v := range v {
+			if match(v) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok && match(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
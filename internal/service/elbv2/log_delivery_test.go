@@ -0,0 +1,188 @@
+package elbv2
+
+import (
+	"testing"
+)
+
+func TestBucketPolicyAllowsLogDelivery(t *testing.T) {
+	principals := []string{
+		logDeliveryServicePrincipal,
+		"127311923021",
+		"arn:aws:iam::127311923021:root",
+	}
+
+	testCases := []struct {
+		Name     string
+		Policy   string
+		Expected bool
+		Error    bool
+	}{
+		{
+			Name: "regional account ARN",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/AWSLogs/123456789012/*"
+  }]
+}`,
+			Expected: true,
+		},
+		{
+			Name: "service principal in list",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": ["logdelivery.elasticloadbalancing.amazonaws.com"]},
+    "Action": ["s3:GetBucketAcl", "s3:PutObject"],
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: true,
+		},
+		{
+			Name: "wrong regional account",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::797873946194:root"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: false,
+		},
+		{
+			Name: "deny statement",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Deny",
+    "Principal": {"AWS": "arn:aws:iam::127311923021:root"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: false,
+		},
+		{
+			Name: "other action",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "logdelivery.elasticloadbalancing.amazonaws.com"},
+    "Action": "s3:GetObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: false,
+		},
+		{
+			Name: "single statement object",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"Service": "logdelivery.elasticloadbalancing.amazonaws.com"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }
+}`,
+			Expected: true,
+		},
+		{
+			Name: "wildcard action",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "127311923021"},
+    "Action": "s3:Put*",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: true,
+		},
+		{
+			Name: "non-matching wildcard action",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "127311923021"},
+    "Action": "s3:Get*",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: false,
+		},
+		{
+			Name: "anonymous principal",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "s3:*",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Expected: true,
+		},
+		{
+			Name:   "invalid JSON",
+			Policy: `{`,
+			Error:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := bucketPolicyAllowsLogDelivery(testCase.Policy, principals)
+
+			if err == nil && testCase.Error {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.Error {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyActionMatches(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Expected bool
+	}{
+		{Pattern: "*", Expected: true},
+		{Pattern: "s3:*", Expected: true},
+		{Pattern: "s3:PutObject", Expected: true},
+		{Pattern: "s3:putobject", Expected: true},
+		{Pattern: "S3:Put*", Expected: true},
+		{Pattern: "s3:Put?bject", Expected: true},
+		{Pattern: "s3:*Object", Expected: true},
+		{Pattern: "s3:PutObjectAcl", Expected: false},
+		{Pattern: "s3:Get*", Expected: false},
+		{Pattern: "ec2:*", Expected: false},
+		{Pattern: "s3.PutObject", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Pattern, func(t *testing.T) {
+			if got := policyActionMatches(testCase.Pattern, "s3:PutObject"); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
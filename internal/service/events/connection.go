@@ -252,6 +252,10 @@ func ResourceConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"wait_for_authorization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -286,6 +290,12 @@ func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for EventBridge connection (%s) to create: %w", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("wait_for_authorization"); ok && v.(bool) {
+		if _, err := waitConnectionAuthorized(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EventBridge connection (%s) to be authorized: %w", d.Id(), err)
+		}
+	}
+
 	return resourceConnectionRead(d, meta)
 }
 
@@ -339,17 +349,26 @@ func resourceConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.Description = aws.String(v.(string))
 	}
 
-	log.Printf("[DEBUG] Updating EventBridge connection: %s", input)
-	_, err := conn.UpdateConnection(input)
+	if d.HasChangesExcept("wait_for_authorization") {
+		log.Printf("[DEBUG] Updating EventBridge connection: %s", input)
+		_, err := conn.UpdateConnection(input)
 
-	if err != nil {
-		return fmt.Errorf("error updating EventBridge connection (%s): %w", d.Id(), err)
-	}
+		if err != nil {
+			return fmt.Errorf("error updating EventBridge connection (%s): %w", d.Id(), err)
+		}
 
-	_, err = waitConnectionUpdated(conn, d.Id())
+		_, err = waitConnectionUpdated(conn, d.Id())
 
-	if err != nil {
-		return fmt.Errorf("error waiting for EventBridge connection (%s) to update: %w", d.Id(), err)
+		if err != nil {
+			return fmt.Errorf("error waiting for EventBridge connection (%s) to update: %w", d.Id(), err)
+		}
+	}
+
+	// Also wait when only wait_for_authorization changes so that enabling it checks an existing connection.
+	if v, ok := d.GetOk("wait_for_authorization"); ok && v.(bool) {
+		if _, err := waitConnectionAuthorized(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EventBridge connection (%s) to be authorized: %w", d.Id(), err)
+		}
 	}
 
 	return resourceConnectionRead(d, meta)
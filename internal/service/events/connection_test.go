@@ -535,6 +535,80 @@ func TestAccEventsConnection_invocationHTTPParameters(t *testing.T) {
 	})
 }
 
+func TestAccEventsConnection_waitForAuthorization(t *testing.T) {
+	var v eventbridge.DescribeConnectionOutput
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_waitForAuthorization(name, "https://example.com/auth"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_authorization", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auth_parameters.0.basic.0.password",
+					"wait_for_authorization",
+				},
+			},
+		},
+	})
+}
+
+func TestAccEventsConnection_waitForAuthorizationOAuthFailure(t *testing.T) {
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionConfig_waitForAuthorizationOAuth(name),
+				ExpectError: regexp.MustCompile(`unexpected state 'DEAUTHORIZED'`),
+			},
+		},
+	})
+}
+
+func TestAccEventsConnection_waitForAuthorizationEnabled(t *testing.T) {
+	var v eventbridge.DescribeConnectionOutput
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_waitForAuthorizationOAuthFlag(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_authorization", "false"),
+				),
+			},
+			{
+				Config:      testAccConnectionConfig_waitForAuthorizationOAuthFlag(name, true),
+				ExpectError: regexp.MustCompile(`unexpected state 'DEAUTHORIZED'`),
+			},
+		},
+	})
+}
+
 func TestAccEventsConnection_disappears(t *testing.T) {
 	var v eventbridge.DescribeConnectionOutput
 	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -822,3 +896,60 @@ resource "aws_cloudwatch_event_connection" "invocation_http_parameters" {
 		queryStringValue,
 		queryStringIsSecretValue)
 }
+
+func testAccConnectionConfig_waitForAuthorization(name, endpoint string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name                   = %[1]q
+  authorization_type     = "BASIC"
+  wait_for_authorization = true
+
+  auth_parameters {
+    basic {
+      username = "user"
+      password = "password"
+    }
+  }
+}
+
+resource "aws_cloudwatch_event_api_destination" "test" {
+  name                = %[1]q
+  invocation_endpoint = %[2]q
+  http_method         = "POST"
+  connection_arn      = aws_cloudwatch_event_connection.test.arn
+}
+`, name, endpoint)
+}
+
+func testAccConnectionConfig_waitForAuthorizationOAuth(name string) string {
+	return testAccConnectionConfig_waitForAuthorizationOAuthFlag(name, true)
+}
+
+func testAccConnectionConfig_waitForAuthorizationOAuthFlag(name string, waitForAuthorization bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name                   = %[1]q
+  authorization_type     = "OAUTH_CLIENT_CREDENTIALS"
+  wait_for_authorization = %[2]t
+
+  auth_parameters {
+    oauth {
+      authorization_endpoint = "https://example.com/auth"
+      http_method            = "POST"
+
+      client_parameters {
+        client_id     = "client"
+        client_secret = "secret"
+      }
+
+      oauth_http_parameters {
+        body {
+          key   = "grant_type"
+          value = "client_credentials"
+        }
+      }
+    }
+  }
+}
+`, name, waitForAuthorization)
+}
@@ -1,16 +1,20 @@
 package events
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
-	connectionCreatedTimeout = 2 * time.Minute
-	connectionDeletedTimeout = 2 * time.Minute
-	connectionUpdatedTimeout = 2 * time.Minute
+	connectionAuthorizedTimeout = 5 * time.Minute
+	connectionCreatedTimeout    = 2 * time.Minute
+	connectionDeletedTimeout    = 2 * time.Minute
+	connectionUpdatedTimeout    = 2 * time.Minute
 )
 
 func waitConnectionCreated(conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeConnectionOutput, error) {
@@ -30,6 +34,29 @@ func waitConnectionCreated(conn *eventbridge.EventBridge, id string) (*eventbrid
 	return nil, err
 }
 
+// waitConnectionAuthorized waits for the connection to reach the AUTHORIZED state.
+// OAuth connections end up DEAUTHORIZED if EventBridge cannot obtain a token with the supplied client credentials.
+func waitConnectionAuthorized(conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeConnectionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.ConnectionStateCreating, eventbridge.ConnectionStateUpdating, eventbridge.ConnectionStateAuthorizing, eventbridge.ConnectionStateDeauthorizing},
+		Target:  []string{eventbridge.ConnectionStateAuthorized},
+		Refresh: statusConnectionState(conn, id),
+		Timeout: connectionAuthorizedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*eventbridge.DescribeConnectionOutput); ok {
+		if reason := aws.StringValue(v.StateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
 func waitConnectionDeleted(conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeConnectionOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eventbridge.ConnectionStateDeleting},
@@ -13,6 +13,7 @@ func TestAccFMS_serial(t *testing.T) {
 			"basic":                  testAccPolicy_basic,
 			"cloudfrontDistribution": testAccPolicy_cloudFrontDistribution,
 			"includeMap":             testAccPolicy_includeMap,
+			"policyOption":           testAccPolicy_policyOption,
 			"update":                 testAccPolicy_update,
 			"resourceTags":           testAccPolicy_resourceTags,
 			"tags":                   testAccPolicy_tags,
@@ -139,6 +139,43 @@ func ResourcePolicy() *schema.Resource {
 							Optional:         true,
 							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 						},
+						"policy_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network_firewall_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"firewall_deployment_model": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(fms.FirewallDeploymentModel_Values(), false),
+												},
+											},
+										},
+									},
+									"third_party_firewall_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"firewall_deployment_model": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(fms.FirewallDeploymentModel_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -306,9 +343,10 @@ func resourcePolicyFlattenPolicy(d *schema.ResourceData, resp *fms.GetPolicyOutp
 		return err
 	}
 
-	securityServicePolicy := []map[string]string{{
-		"type":                 *resp.Policy.SecurityServicePolicyData.Type,
-		"managed_service_data": *resp.Policy.SecurityServicePolicyData.ManagedServiceData,
+	securityServicePolicy := []map[string]interface{}{{
+		"type":                 aws.StringValue(resp.Policy.SecurityServicePolicyData.Type),
+		"managed_service_data": aws.StringValue(resp.Policy.SecurityServicePolicyData.ManagedServiceData),
+		"policy_option":        flattenPolicyOption(resp.Policy.SecurityServicePolicyData.PolicyOption),
 	}}
 	if err := d.Set("security_service_policy_data", securityServicePolicy); err != nil {
 		return err
@@ -350,9 +388,61 @@ func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 		Type:               aws.String(securityServicePolicy["type"].(string)),
 	}
 
+	if v, ok := securityServicePolicy["policy_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		fmsPolicy.SecurityServicePolicyData.PolicyOption = expandPolicyOption(v[0].(map[string]interface{}))
+	}
+
 	return fmsPolicy
 }
 
+func expandPolicyOption(tfMap map[string]interface{}) *fms.PolicyOption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &fms.PolicyOption{}
+
+	if v, ok := tfMap["network_firewall_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkFirewallPolicy = &fms.NetworkFirewallPolicy{}
+
+		if v, ok := v[0].(map[string]interface{})["firewall_deployment_model"].(string); ok && v != "" {
+			apiObject.NetworkFirewallPolicy.FirewallDeploymentModel = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["third_party_firewall_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ThirdPartyFirewallPolicy = &fms.ThirdPartyFirewallPolicy{}
+
+		if v, ok := v[0].(map[string]interface{})["firewall_deployment_model"].(string); ok && v != "" {
+			apiObject.ThirdPartyFirewallPolicy.FirewallDeploymentModel = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPolicyOption(apiObject *fms.PolicyOption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NetworkFirewallPolicy; v != nil {
+		tfMap["network_firewall_policy"] = []interface{}{map[string]interface{}{
+			"firewall_deployment_model": aws.StringValue(v.FirewallDeploymentModel),
+		}}
+	}
+
+	if v := apiObject.ThirdPartyFirewallPolicy; v != nil {
+		tfMap["third_party_firewall_policy"] = []interface{}{map[string]interface{}{
+			"firewall_deployment_model": aws.StringValue(v.FirewallDeploymentModel),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandPolicyMap(set []interface{}) map[string][]*string {
 	fmsPolicyMap := map[string][]*string{}
 	if len(set) > 0 {
@@ -83,6 +83,42 @@ func testAccPolicy_cloudFrontDistribution(t *testing.T) {
 	})
 }
 
+func testAccPolicy_policyOption(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, fms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_policyOption(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "NETWORK_FIREWALL"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_firewall_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_firewall_policy.0.firewall_deployment_model", "CENTRALIZED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
 func testAccPolicy_includeMap(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_fms_policy.test"
@@ -377,6 +413,41 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName))
 }
 
+func testAccPolicyConfig_policyOption(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfigOrgMgmtAccountBase(), fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::VPC"
+
+  security_service_policy_data {
+    type = "NETWORK_FIREWALL"
+    managed_service_data = jsonencode({
+      type                                           = "NETWORK_FIREWALL"
+      networkFirewallStatelessRuleGroupReferences    = []
+      networkFirewallStatelessDefaultActions         = ["aws:forward_to_sfe"]
+      networkFirewallStatelessFragmentDefaultActions = ["aws:forward_to_sfe"]
+      networkFirewallStatelessCustomActions          = []
+      networkFirewallStatefulRuleGroupReferences     = []
+      networkFirewallOrchestrationConfig = {
+        singleFirewallEndpointPerVPC = false
+        allowedIPV4CidrList          = []
+      }
+    })
+
+    policy_option {
+      network_firewall_policy {
+        firewall_deployment_model = "CENTRALIZED"
+      }
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
+
 func testAccPolicyConfig_updated(policyName, ruleGroupName string) string {
 	return acctest.ConfigCompose(testAccPolicyConfigOrgMgmtAccountBase(), fmt.Sprintf(`
 resource "aws_fms_policy" "test" {
@@ -1,6 +1,7 @@
 package fsx
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -145,10 +146,67 @@ func ResourceDataRepositoryAssociation() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			resourceDataRepositoryAssociationS3CustomizeDiff,
 		),
 	}
 }
 
+// resourceDataRepositoryAssociationS3CustomizeDiff rejects automatic import and export policies
+// that the association's file system would refuse at apply time.
+func resourceDataRepositoryAssociationS3CustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("s3", "file_system_id") {
+		return nil
+	}
+
+	for _, k := range []string{"s3.0.auto_export_policy.0.events", "s3.0.auto_import_policy.0.events"} {
+		if !diff.NewValueKnown(k) {
+			continue
+		}
+
+		seen := make(map[string]bool)
+
+		for _, v := range diff.Get(k).([]interface{}) {
+			event, _ := v.(string)
+
+			if seen[event] {
+				return fmt.Errorf("%s: event %s is specified more than once", k, event)
+			}
+
+			seen[event] = true
+		}
+	}
+
+	// Automatic export is only available on PERSISTENT_2 file systems.
+	if !diff.NewValueKnown("s3.0.auto_export_policy.0.events") || !diff.NewValueKnown("file_system_id") {
+		return nil
+	}
+
+	if len(diff.Get("s3.0.auto_export_policy.0.events").([]interface{})) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).FSxConn
+	fileSystemID := diff.Get("file_system_id").(string)
+
+	filesystem, err := FindFileSystemByID(conn, fileSystemID)
+
+	// The file system may not exist yet or may not be readable; leave any error to the API at apply time.
+	if err != nil {
+		log.Printf("[WARN] Unable to read FSx Lustre File System (%s), skipping automatic export check: %s", fileSystemID, err)
+		return nil
+	}
+
+	if filesystem.LustreConfiguration == nil {
+		return nil
+	}
+
+	if deploymentType := aws.StringValue(filesystem.LustreConfiguration.DeploymentType); deploymentType != fsx.LustreDeploymentTypePersistent2 {
+		return fmt.Errorf("s3.0.auto_export_policy: automatic export is not supported by FSx Lustre File System (%s) with deployment type %s", fileSystemID, deploymentType)
+	}
+
+	return nil
+}
+
 func resourceDataRepositoryAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).FSxConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -345,6 +345,52 @@ func TestAccFSxDataRepositoryAssociation_s3AutoExportPolicy(t *testing.T) {
 	})
 }
 
+func TestAccFSxDataRepositoryAssociation_s3AutoExportPolicyDuplicateEvents(t *testing.T) {
+	if acctest.Partition() == endpoints.AwsUsGovPartitionID {
+		t.Skip("PERSISTENT_2 deployment_type is not supported in GovCloud partition")
+	}
+
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	fileSystemPath := "/test"
+	events := []string{"NEW", "NEW"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataRepositoryAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataRepositoryAssociationConfig_s3AutoExportPolicy(bucketName, fileSystemPath, events),
+				ExpectError: regexp.MustCompile(`event NEW is specified more than once`),
+			},
+		},
+	})
+}
+
+func TestAccFSxDataRepositoryAssociation_s3AutoExportPolicyUnsupportedDeploymentType(t *testing.T) {
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	fileSystemPath := "/test"
+	events := []string{"NEW"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(fsx.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, fsx.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataRepositoryAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The file system must exist before the plan-time check can look up its deployment type.
+				Config: testAccDataRepositoryAssociationScratchBucketConfig(bucketName),
+			},
+			{
+				Config:      testAccDataRepositoryAssociationConfig_s3AutoExportPolicyScratch(bucketName, fileSystemPath, events),
+				ExpectError: regexp.MustCompile(`automatic export is not supported by FSx Lustre File System \(fs-[0-9a-f]+\) with deployment type SCRATCH_2`),
+			},
+		},
+	})
+}
+
 func TestAccFSxDataRepositoryAssociation_s3AutoExportPolicyUpdate(t *testing.T) {
 	if acctest.Partition() == endpoints.AwsUsGovPartitionID {
 		t.Skip("PERSISTENT_2 deployment_type is not supported in GovCloud partition")
@@ -645,6 +691,43 @@ resource "aws_fsx_data_repository_association" "test" {
 `, bucketPath, fileSystemPath, eventsString))
 }
 
+func testAccDataRepositoryAssociationScratchBucketConfig(bucketName string) string {
+	return acctest.ConfigCompose(testAccLustreFileSystemBaseConfig(), fmt.Sprintf(`
+resource "aws_fsx_lustre_file_system" "test" {
+  storage_capacity = 1200
+  subnet_ids       = [aws_subnet.test1.id]
+  deployment_type  = "SCRATCH_2"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket.test.id
+  acl    = "private"
+}
+`, bucketName))
+}
+
+func testAccDataRepositoryAssociationConfig_s3AutoExportPolicyScratch(bucketName, fileSystemPath string, events []string) string {
+	bucketPath := fmt.Sprintf("s3://%s", bucketName)
+	eventsString := strings.Replace(fmt.Sprintf("%q", events), " ", ", ", -1)
+	return acctest.ConfigCompose(testAccDataRepositoryAssociationScratchBucketConfig(bucketName), fmt.Sprintf(`
+resource "aws_fsx_data_repository_association" "test" {
+  file_system_id       = aws_fsx_lustre_file_system.test.id
+  data_repository_path = %[1]q
+  file_system_path     = %[2]q
+
+  s3 {
+    auto_export_policy {
+      events = %[3]s
+    }
+  }
+}
+`, bucketPath, fileSystemPath, eventsString))
+}
+
 func testAccDataRepositoryAssociationConfig_s3AutoImportPolicy(bucketName, fileSystemPath string, events []string) string {
 	bucketPath := fmt.Sprintf("s3://%s", bucketName)
 	eventsString := strings.Replace(fmt.Sprintf("%q", events), " ", ", ", -1)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -80,6 +81,57 @@ func DataSourceWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"saml_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_role_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_organizations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"editor_role_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"email_assertion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"groups_assertion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"login_assertion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"login_validity_duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name_assertion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"org_assertion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_assertion": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"saml_configuration_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -140,9 +192,50 @@ func dataSourceWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("stack_set_name", workspace.StackSetName)
 	d.Set("status", workspace.Status)
 
+	if aws.StringValue(workspace.Authentication.SamlConfigurationStatus) == managedgrafana.SamlConfigurationStatusConfigured {
+		saml, err := FindSamlConfigurationByID(conn, workspaceID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Grafana Workspace (%s) SAML configuration: %w", workspaceID, err)
+		}
+
+		if err := d.Set("saml_configuration", flattenSAMLConfiguration(saml.Configuration)); err != nil {
+			return fmt.Errorf("error setting saml_configuration: %w", err)
+		}
+	} else {
+		d.Set("saml_configuration", nil)
+	}
+
 	if err := d.Set("tags", KeyValueTags(workspace.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
 	return nil
 }
+
+func flattenSAMLConfiguration(apiObject *managedgrafana.SamlConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed_organizations":   aws.StringValueSlice(apiObject.AllowedOrganizations),
+		"login_validity_duration": aws.Int64Value(apiObject.LoginValidityDuration),
+	}
+
+	if v := apiObject.AssertionAttributes; v != nil {
+		tfMap["email_assertion"] = aws.StringValue(v.Email)
+		tfMap["groups_assertion"] = aws.StringValue(v.Groups)
+		tfMap["login_assertion"] = aws.StringValue(v.Login)
+		tfMap["name_assertion"] = aws.StringValue(v.Name)
+		tfMap["org_assertion"] = aws.StringValue(v.Org)
+		tfMap["role_assertion"] = aws.StringValue(v.Role)
+	}
+
+	if v := apiObject.RoleValues; v != nil {
+		tfMap["admin_role_values"] = aws.StringValueSlice(v.Admin)
+		tfMap["editor_role_values"] = aws.StringValueSlice(v.Editor)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -39,6 +39,7 @@ func testAccWorkspaceDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "organizational_units.#", dataSourceName, "organizational_units.#"),
 					resource.TestCheckResourceAttrPair(resourceName, "permission_type", dataSourceName, "permission_type"),
 					resource.TestCheckResourceAttrPair(resourceName, "role_arn", dataSourceName, "role_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "saml_configuration.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "saml_configuration_status", dataSourceName, "saml_configuration_status"),
 					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", dataSourceName, "stack_set_name"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
@@ -49,6 +50,39 @@ func testAccWorkspaceDataSource_basic(t *testing.T) {
 	})
 }
 
+func testAccWorkspaceDataSource_samlConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_saml_configuration.test"
+	dataSourceName := "data.aws_grafana_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		CheckDestroy:      nil,
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceDataSourceConfig_samlConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "saml_configuration_status", managedgrafana.SamlConfigurationStatusConfigured),
+					resource.TestCheckResourceAttr(dataSourceName, "saml_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "admin_role_values.#", dataSourceName, "saml_configuration.0.admin_role_values.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "admin_role_values.0", dataSourceName, "saml_configuration.0.admin_role_values.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "editor_role_values.#", dataSourceName, "saml_configuration.0.editor_role_values.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "editor_role_values.0", dataSourceName, "saml_configuration.0.editor_role_values.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "email_assertion", dataSourceName, "saml_configuration.0.email_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "groups_assertion", dataSourceName, "saml_configuration.0.groups_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "login_assertion", dataSourceName, "saml_configuration.0.login_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "login_validity_duration", dataSourceName, "saml_configuration.0.login_validity_duration"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_assertion", dataSourceName, "saml_configuration.0.name_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "org_assertion", dataSourceName, "saml_configuration.0.org_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_assertion", dataSourceName, "saml_configuration.0.role_assertion"),
+				),
+			},
+		},
+	})
+}
+
 func testAccWorkspaceDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccWorkspaceConfig_authenticationProvider(rName, "SAML"), `
 data "aws_grafana_workspace" "test" {
@@ -56,3 +90,11 @@ data "aws_grafana_workspace" "test" {
 }
 `)
 }
+
+func testAccWorkspaceDataSourceConfig_samlConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceSAMLConfigurationConfig_providerAssertions(rName), `
+data "aws_grafana_workspace" "test" {
+  workspace_id = aws_grafana_workspace_saml_configuration.test.workspace_id
+}
+`)
+}
@@ -28,7 +28,8 @@ func TestAccGrafana_serial(t *testing.T) {
 			"tags":                     testAccWorkspace_tags,
 		},
 		"DataSource": {
-			"basic": testAccWorkspaceDataSource_basic,
+			"basic":             testAccWorkspaceDataSource_basic,
+			"samlConfiguration": testAccWorkspaceDataSource_samlConfiguration,
 		},
 		"LicenseAssociation": {
 			"enterpriseFreeTrial": testAccLicenseAssociation_freeTrial,
@@ -0,0 +1,257 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2Deployment_basic(t *testing.T) {
+	var v greengrassv2.GetDeploymentOutput
+	resourceName := "aws_greengrassv2_deployment.test"
+	thingGroupResourceName := "aws_iot_thing_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, componentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":    componentName,
+						"component_version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_status", greengrassv2.DeploymentStatusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", thingGroupResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_disappears(t *testing.T) {
+	var v greengrassv2.GetDeploymentOutput
+	resourceName := "aws_greengrassv2_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, componentName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrassv2.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_configuration(t *testing.T) {
+	var v1, v2 greengrassv2.GetDeploymentOutput
+	resourceName := "aws_greengrassv2_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_configuration(rName, componentName, "Hello", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":                             componentName,
+						"configuration_update.0.merge":               `{"message":"Hello"}`,
+						"configuration_update.0.reset.#":             "1",
+						"run_with.0.posix_user":                      "ggc_user",
+						"run_with.0.system_resource_limits.0.memory": "102400",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.failure_handling_policy", greengrassv2.DeploymentFailureHandlingPolicyDoNothing),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.maximum_per_minute", "10"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.timeout_config.0.in_progress_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfig_configuration(rName, componentName, "Goodbye", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName, &v2),
+					testAccCheckDeploymentRevised(&v1, &v2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"configuration_update.0.merge": `{"message":"Goodbye"}`,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.maximum_per_minute", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrassv2_deployment" {
+			continue
+		}
+
+		_, err := tfgreengrassv2.FindDeploymentByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass V2 Deployment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDeploymentExists(n string, v *greengrassv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+		output, err := tfgreengrassv2.FindDeploymentByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentRevised(before, after *greengrassv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.DeploymentId) == aws.StringValue(after.DeploymentId) {
+			return fmt.Errorf("Greengrass V2 Deployment (%s) not revised", aws.StringValue(before.DeploymentId))
+		}
+
+		if aws.StringValue(before.TargetArn) != aws.StringValue(after.TargetArn) {
+			return fmt.Errorf("Greengrass V2 Deployment target changed from %s to %s", aws.StringValue(before.TargetArn), aws.StringValue(after.TargetArn))
+		}
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfigBase(rName, componentName string) string {
+	return acctest.ConfigCompose(testAccComponentVersionConfig_basic(componentName, "1.0.0"), fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_basic(rName, componentName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfigBase(rName, componentName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_configuration(rName, componentName, message string, maximumPerMinute int) string {
+	return acctest.ConfigCompose(testAccDeploymentConfigBase(rName, componentName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+
+    configuration_update {
+      merge = jsonencode({
+        message = %[2]q
+      })
+      reset = ["/interval"]
+    }
+
+    run_with {
+      posix_user = "ggc_user"
+
+      system_resource_limits {
+        memory = 102400
+      }
+    }
+  }
+
+  deployment_policies {
+    failure_handling_policy = "DO_NOTHING"
+  }
+
+  iot_job_configuration {
+    job_executions_rollout_config {
+      maximum_per_minute = %[3]d
+    }
+
+    timeout_config {
+      in_progress_timeout_in_minutes = 60
+    }
+  }
+}
+`, rName, message, maximumPerMinute))
+}
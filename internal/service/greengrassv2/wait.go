@@ -0,0 +1,33 @@
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitComponentVersionCreated(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if v := output.Status.Message; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
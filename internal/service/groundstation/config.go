@@ -0,0 +1,896 @@
+package groundstation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var configDataKeys = []string{
+	"config_data.0.antenna_downlink_config",
+	"config_data.0.antenna_downlink_demod_decode_config",
+	"config_data.0.antenna_uplink_config",
+	"config_data.0.dataflow_endpoint_config",
+	"config_data.0.s3_recording_config",
+	"config_data.0.tracking_config",
+	"config_data.0.uplink_echo_config",
+}
+
+func ResourceConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigCreate,
+		ReadWithoutTimeout:   resourceConfigRead,
+		UpdateWithoutTimeout: resourceConfigUpdate,
+		DeleteWithoutTimeout: resourceConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"antenna_downlink_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_downlink_demod_decode_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decode_config":       unvalidatedJSONConfigSchema(),
+									"demodulation_config": unvalidatedJSONConfigSchema(),
+									"spectrum_config":     spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_uplink_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"center_frequency": valueWithUnitsSchema(groundstation.FrequencyUnits_Values()),
+												"polarization": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(groundstation.Polarization_Values(), false),
+												},
+											},
+										},
+									},
+									"target_eirp": valueWithUnitsSchema(groundstation.EirpUnits_Values()),
+									"transmit_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"dataflow_endpoint_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dataflow_endpoint_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"dataflow_endpoint_region": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"s3_recording_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 900),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"tracking_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"autotrack": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(groundstation.Criticality_Values(), false),
+									},
+								},
+							},
+						},
+						"uplink_echo_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"antenna_uplink_config_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func spectrumConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bandwidth":        valueWithUnitsSchema(groundstation.BandwidthUnits_Values()),
+				"center_frequency": valueWithUnitsSchema(groundstation.FrequencyUnits_Values()),
+				"polarization": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(groundstation.Polarization_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func unvalidatedJSONConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"unvalidated_json": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+					StateFunc: func(v interface{}) string {
+						json, _ := structure.NormalizeJsonString(v)
+						return json
+					},
+				},
+			},
+		},
+	}
+}
+
+func valueWithUnitsSchema(units []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"units": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(units, false),
+				},
+				"value": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &groundstation.CreateConfigInput{
+		ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})[0].(map[string]interface{})),
+		Name:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Ground Station Config: %s", input)
+	output, err := conn.CreateConfigWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Ground Station Config (%s): %s", name, err)
+	}
+
+	d.SetId(ConfigCreateResourceID(aws.StringValue(output.ConfigId), aws.StringValue(output.ConfigType)))
+
+	return resourceConfigRead(ctx, d, meta)
+}
+
+func resourceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configID, configType, err := ConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindConfigByTwoPartKey(ctx, conn, configID, configType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Ground Station Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ConfigArn)
+	if err := d.Set("config_data", []interface{}{flattenConfigTypeData(output.ConfigData)}); err != nil {
+		return diag.Errorf("setting config_data: %s", err)
+	}
+	d.Set("config_id", output.ConfigId)
+	d.Set("config_type", output.ConfigType)
+	d.Set("name", output.Name)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		configID, configType, err := ConfigParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})[0].(map[string]interface{})),
+			ConfigId:   aws.String(configID),
+			ConfigType: aws.String(configType),
+			Name:       aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Ground Station Config: %s", input)
+		_, err = conn.UpdateConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Ground Station Config (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Ground Station Config (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceConfigRead(ctx, d, meta)
+}
+
+func resourceConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	configID, configType, err := ConfigParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Ground Station Config: %s", d.Id())
+	_, err = conn.DeleteConfigWithContext(ctx, &groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: aws.String(configType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Ground Station Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const configResourceIDSeparator = ","
+
+func ConfigCreateResourceID(configID, configType string) string {
+	parts := []string{configID, configType}
+	id := strings.Join(parts, configResourceIDSeparator)
+
+	return id
+}
+
+func ConfigParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, configResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIG-ID%[2]sCONFIG-TYPE", id, configResourceIDSeparator)
+}
+
+func expandConfigTypeData(tfMap map[string]interface{}) *groundstation.ConfigTypeData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.ConfigTypeData{}
+
+	if v, ok := tfMap["antenna_downlink_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AntennaDownlinkConfig = expandAntennaDownlinkConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["antenna_downlink_demod_decode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AntennaDownlinkDemodDecodeConfig = expandAntennaDownlinkDemodDecodeConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["antenna_uplink_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AntennaUplinkConfig = expandAntennaUplinkConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dataflow_endpoint_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataflowEndpointConfig = expandDataflowEndpointConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_recording_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3RecordingConfig = expandS3RecordingConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["tracking_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TrackingConfig = expandTrackingConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["uplink_echo_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UplinkEchoConfig = expandUplinkEchoConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAntennaDownlinkConfig(tfMap map[string]interface{}) *groundstation.AntennaDownlinkConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.AntennaDownlinkConfig{}
+
+	if v, ok := tfMap["spectrum_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpectrumConfig = expandSpectrumConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAntennaDownlinkDemodDecodeConfig(tfMap map[string]interface{}) *groundstation.AntennaDownlinkDemodDecodeConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.AntennaDownlinkDemodDecodeConfig{}
+
+	if v, ok := tfMap["decode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DecodeConfig = &groundstation.DecodeConfig{
+			UnvalidatedJSON: aws.String(v[0].(map[string]interface{})["unvalidated_json"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["demodulation_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DemodulationConfig = &groundstation.DemodulationConfig{
+			UnvalidatedJSON: aws.String(v[0].(map[string]interface{})["unvalidated_json"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["spectrum_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpectrumConfig = expandSpectrumConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAntennaUplinkConfig(tfMap map[string]interface{}) *groundstation.AntennaUplinkConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.AntennaUplinkConfig{}
+
+	if v, ok := tfMap["spectrum_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SpectrumConfig = expandUplinkSpectrumConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["target_eirp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.TargetEirp = &groundstation.Eirp{
+			Units: aws.String(tfMap["units"].(string)),
+			Value: aws.Float64(tfMap["value"].(float64)),
+		}
+	}
+
+	if v, ok := tfMap["transmit_disabled"].(bool); ok {
+		apiObject.TransmitDisabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSpectrumConfig(tfMap map[string]interface{}) *groundstation.SpectrumConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.SpectrumConfig{}
+
+	if v, ok := tfMap["bandwidth"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Bandwidth = &groundstation.FrequencyBandwidth{
+			Units: aws.String(tfMap["units"].(string)),
+			Value: aws.Float64(tfMap["value"].(float64)),
+		}
+	}
+
+	if v, ok := tfMap["center_frequency"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CenterFrequency = expandFrequency(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["polarization"].(string); ok && v != "" {
+		apiObject.Polarization = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUplinkSpectrumConfig(tfMap map[string]interface{}) *groundstation.UplinkSpectrumConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.UplinkSpectrumConfig{}
+
+	if v, ok := tfMap["center_frequency"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CenterFrequency = expandFrequency(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["polarization"].(string); ok && v != "" {
+		apiObject.Polarization = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFrequency(tfMap map[string]interface{}) *groundstation.Frequency {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.Frequency{
+		Units: aws.String(tfMap["units"].(string)),
+		Value: aws.Float64(tfMap["value"].(float64)),
+	}
+
+	return apiObject
+}
+
+func expandDataflowEndpointConfig(tfMap map[string]interface{}) *groundstation.DataflowEndpointConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.DataflowEndpointConfig{}
+
+	if v, ok := tfMap["dataflow_endpoint_name"].(string); ok && v != "" {
+		apiObject.DataflowEndpointName = aws.String(v)
+	}
+
+	if v, ok := tfMap["dataflow_endpoint_region"].(string); ok && v != "" {
+		apiObject.DataflowEndpointRegion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3RecordingConfig(tfMap map[string]interface{}) *groundstation.S3RecordingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.S3RecordingConfig{}
+
+	if v, ok := tfMap["bucket_arn"].(string); ok && v != "" {
+		apiObject.BucketArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTrackingConfig(tfMap map[string]interface{}) *groundstation.TrackingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.TrackingConfig{}
+
+	if v, ok := tfMap["autotrack"].(string); ok && v != "" {
+		apiObject.Autotrack = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUplinkEchoConfig(tfMap map[string]interface{}) *groundstation.UplinkEchoConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.UplinkEchoConfig{}
+
+	if v, ok := tfMap["antenna_uplink_config_arn"].(string); ok && v != "" {
+		apiObject.AntennaUplinkConfigArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenConfigTypeData(apiObject *groundstation.ConfigTypeData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AntennaDownlinkConfig; v != nil {
+		tfMap["antenna_downlink_config"] = []interface{}{flattenAntennaDownlinkConfig(v)}
+	}
+
+	if v := apiObject.AntennaDownlinkDemodDecodeConfig; v != nil {
+		tfMap["antenna_downlink_demod_decode_config"] = []interface{}{flattenAntennaDownlinkDemodDecodeConfig(v)}
+	}
+
+	if v := apiObject.AntennaUplinkConfig; v != nil {
+		tfMap["antenna_uplink_config"] = []interface{}{flattenAntennaUplinkConfig(v)}
+	}
+
+	if v := apiObject.DataflowEndpointConfig; v != nil {
+		tfMap["dataflow_endpoint_config"] = []interface{}{flattenDataflowEndpointConfig(v)}
+	}
+
+	if v := apiObject.S3RecordingConfig; v != nil {
+		tfMap["s3_recording_config"] = []interface{}{flattenS3RecordingConfig(v)}
+	}
+
+	if v := apiObject.TrackingConfig; v != nil {
+		tfMap["tracking_config"] = []interface{}{flattenTrackingConfig(v)}
+	}
+
+	if v := apiObject.UplinkEchoConfig; v != nil {
+		tfMap["uplink_echo_config"] = []interface{}{flattenUplinkEchoConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAntennaDownlinkConfig(apiObject *groundstation.AntennaDownlinkConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SpectrumConfig; v != nil {
+		tfMap["spectrum_config"] = []interface{}{flattenSpectrumConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAntennaDownlinkDemodDecodeConfig(apiObject *groundstation.AntennaDownlinkDemodDecodeConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DecodeConfig; v != nil {
+		tfMap["decode_config"] = []interface{}{map[string]interface{}{
+			"unvalidated_json": aws.StringValue(v.UnvalidatedJSON),
+		}}
+	}
+
+	if v := apiObject.DemodulationConfig; v != nil {
+		tfMap["demodulation_config"] = []interface{}{map[string]interface{}{
+			"unvalidated_json": aws.StringValue(v.UnvalidatedJSON),
+		}}
+	}
+
+	if v := apiObject.SpectrumConfig; v != nil {
+		tfMap["spectrum_config"] = []interface{}{flattenSpectrumConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAntennaUplinkConfig(apiObject *groundstation.AntennaUplinkConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"transmit_disabled": aws.BoolValue(apiObject.TransmitDisabled),
+	}
+
+	if v := apiObject.SpectrumConfig; v != nil {
+		tfMap["spectrum_config"] = []interface{}{flattenUplinkSpectrumConfig(v)}
+	}
+
+	if v := apiObject.TargetEirp; v != nil {
+		tfMap["target_eirp"] = []interface{}{map[string]interface{}{
+			"units": aws.StringValue(v.Units),
+			"value": aws.Float64Value(v.Value),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenSpectrumConfig(apiObject *groundstation.SpectrumConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bandwidth; v != nil {
+		tfMap["bandwidth"] = []interface{}{map[string]interface{}{
+			"units": aws.StringValue(v.Units),
+			"value": aws.Float64Value(v.Value),
+		}}
+	}
+
+	if v := apiObject.CenterFrequency; v != nil {
+		tfMap["center_frequency"] = []interface{}{flattenFrequency(v)}
+	}
+
+	if v := apiObject.Polarization; v != nil {
+		tfMap["polarization"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenUplinkSpectrumConfig(apiObject *groundstation.UplinkSpectrumConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CenterFrequency; v != nil {
+		tfMap["center_frequency"] = []interface{}{flattenFrequency(v)}
+	}
+
+	if v := apiObject.Polarization; v != nil {
+		tfMap["polarization"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenFrequency(apiObject *groundstation.Frequency) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"units": aws.StringValue(apiObject.Units),
+		"value": aws.Float64Value(apiObject.Value),
+	}
+
+	return tfMap
+}
+
+func flattenDataflowEndpointConfig(apiObject *groundstation.DataflowEndpointConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataflowEndpointName; v != nil {
+		tfMap["dataflow_endpoint_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DataflowEndpointRegion; v != nil {
+		tfMap["dataflow_endpoint_region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3RecordingConfig(apiObject *groundstation.S3RecordingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BucketArn; v != nil {
+		tfMap["bucket_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTrackingConfig(apiObject *groundstation.TrackingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Autotrack; v != nil {
+		tfMap["autotrack"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenUplinkEchoConfig(apiObject *groundstation.UplinkEchoConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AntennaUplinkConfigArn; v != nil {
+		tfMap["antenna_uplink_config_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
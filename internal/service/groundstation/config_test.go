@@ -0,0 +1,353 @@
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGroundStationConfig_basic(t *testing.T) {
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, groundstation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, groundstation.CriticalityPreferred),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", groundstation.CriticalityPreferred),
+					resource.TestCheckResourceAttrSet(resourceName, "config_id"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeTracking),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tracking(rName, groundstation.CriticalityRequired),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", groundstation.CriticalityRequired),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_disappears(t *testing.T) {
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, groundstation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, groundstation.CriticalityPreferred),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgroundstation.ResourceConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_tags(t *testing.T) {
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, groundstation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlink(t *testing.T) {
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, groundstation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaDownlink(rName, 30, 7812),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.units", groundstation.BandwidthUnitsMhz),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.units", groundstation.FrequencyUnitsMhz),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.value", "7812"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.polarization", groundstation.PolarizationRightHand),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeAntennaDownlink),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_antennaDownlink(rName, 40, 8212),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.value", "40"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.value", "8212"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_dataflowEndpoint(t *testing.T) {
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, groundstation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_dataflowEndpoint(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.dataflow_endpoint_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.dataflow_endpoint_config.0.dataflow_endpoint_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "config_type", groundstation.ConfigCapabilityTypeDataflowEndpoint),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_groundstation_config" {
+			continue
+		}
+
+		configID, configType, err := tfgroundstation.ConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgroundstation.FindConfigByTwoPartKey(context.Background(), conn, configID, configType)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Ground Station Config %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfigExists(n string, v *groundstation.GetConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Ground Station Config ID is set")
+		}
+
+		configID, configType, err := tfgroundstation.ConfigParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationConn
+
+		output, err := tfgroundstation.FindConfigByTwoPartKey(context.Background(), conn, configID, configType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfigConfig_tracking(rName, autotrack string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = %[2]q
+    }
+  }
+}
+`, rName, autotrack)
+}
+
+func testAccConfigConfig_antennaDownlink(rName string, bandwidth, centerFrequency int) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = %[2]d
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = %[3]d
+        }
+      }
+    }
+  }
+}
+`, rName, bandwidth, centerFrequency)
+}
+
+func testAccConfigConfig_dataflowEndpoint(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    dataflow_endpoint_config {
+      dataflow_endpoint_name   = %[1]q
+      dataflow_endpoint_region = data.aws_region.current.name
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
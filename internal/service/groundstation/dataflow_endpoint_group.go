@@ -0,0 +1,386 @@
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataflowEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataflowEndpointGroupCreate,
+		ReadWithoutTimeout:   resourceDataflowEndpointGroupRead,
+		UpdateWithoutTimeout: resourceDataflowEndpointGroupUpdate,
+		DeleteWithoutTimeout: resourceDataflowEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_details": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"mtu": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1400, 1500),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"security_details": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataflowEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &groundstation.CreateDataflowEndpointGroupInput{
+		EndpointDetails: expandEndpointDetailsList(d.Get("endpoint_details").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Ground Station Dataflow Endpoint Group: %s", input)
+	output, err := conn.CreateDataflowEndpointGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Ground Station Dataflow Endpoint Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DataflowEndpointGroupId))
+
+	return resourceDataflowEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceDataflowEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDataflowEndpointGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Dataflow Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Ground Station Dataflow Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.DataflowEndpointGroupArn)
+	if err := d.Set("endpoint_details", flattenEndpointDetailsList(output.EndpointsDetails)); err != nil {
+		return diag.Errorf("setting endpoint_details: %s", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataflowEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Ground Station Dataflow Endpoint Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataflowEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceDataflowEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	log.Printf("[DEBUG] Deleting Ground Station Dataflow Endpoint Group: %s", d.Id())
+	_, err := conn.DeleteDataflowEndpointGroupWithContext(ctx, &groundstation.DeleteDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Ground Station Dataflow Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEndpointDetailsList(tfList []interface{}) []*groundstation.EndpointDetails {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*groundstation.EndpointDetails
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandEndpointDetails(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandEndpointDetails(tfMap map[string]interface{}) *groundstation.EndpointDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.EndpointDetails{}
+
+	if v, ok := tfMap["endpoint"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Endpoint = expandDataflowEndpoint(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["security_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecurityDetails = expandSecurityDetails(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDataflowEndpoint(tfMap map[string]interface{}) *groundstation.DataflowEndpoint {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.DataflowEndpoint{}
+
+	if v, ok := tfMap["address"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Address = &groundstation.SocketAddress{
+			Name: aws.String(tfMap["name"].(string)),
+			Port: aws.Int64(int64(tfMap["port"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["mtu"].(int); ok && v != 0 {
+		apiObject.Mtu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSecurityDetails(tfMap map[string]interface{}) *groundstation.SecurityDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &groundstation.SecurityDetails{}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenEndpointDetailsList(apiObjects []*groundstation.EndpointDetails) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenEndpointDetails(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenEndpointDetails(apiObject *groundstation.EndpointDetails) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Endpoint; v != nil {
+		tfMap["endpoint"] = []interface{}{flattenDataflowEndpoint(v)}
+	}
+
+	if v := apiObject.SecurityDetails; v != nil {
+		tfMap["security_details"] = []interface{}{flattenSecurityDetails(v)}
+	}
+
+	return tfMap
+}
+
+func flattenDataflowEndpoint(apiObject *groundstation.DataflowEndpoint) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Address; v != nil {
+		tfMap["address"] = []interface{}{map[string]interface{}{
+			"name": aws.StringValue(v.Name),
+			"port": aws.Int64Value(v.Port),
+		}}
+	}
+
+	if v := apiObject.Mtu; v != nil {
+		tfMap["mtu"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSecurityDetails(apiObject *groundstation.SecurityDetails) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SecurityGroupIds; v != nil {
+		tfMap["security_group_ids"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SubnetIds; v != nil {
+		tfMap["subnet_ids"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,271 @@
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMissionProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMissionProfileCreate,
+		ReadWithoutTimeout:   resourceMissionProfileRead,
+		UpdateWithoutTimeout: resourceMissionProfileUpdate,
+		DeleteWithoutTimeout: resourceMissionProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_post_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 21600),
+			},
+			"contact_pre_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 21600),
+			},
+			"dataflow_edge": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"minimum_viable_contact_duration_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 21600),
+			},
+			"mission_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tracking_config_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMissionProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &groundstation.CreateMissionProfileInput{
+		DataflowEdges:                       expandDataflowEdges(d.Get("dataflow_edge").([]interface{})),
+		MinimumViableContactDurationSeconds: aws.Int64(int64(d.Get("minimum_viable_contact_duration_seconds").(int))),
+		Name:                                aws.String(name),
+		TrackingConfigArn:                   aws.String(d.Get("tracking_config_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("contact_post_pass_duration_seconds"); ok {
+		input.ContactPostPassDurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("contact_pre_pass_duration_seconds"); ok {
+		input.ContactPrePassDurationSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Ground Station Mission Profile: %s", input)
+	output, err := conn.CreateMissionProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Ground Station Mission Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MissionProfileId))
+
+	return resourceMissionProfileRead(ctx, d, meta)
+}
+
+func resourceMissionProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindMissionProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Mission Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Ground Station Mission Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.MissionProfileArn)
+	d.Set("contact_post_pass_duration_seconds", output.ContactPostPassDurationSeconds)
+	d.Set("contact_pre_pass_duration_seconds", output.ContactPrePassDurationSeconds)
+	if err := d.Set("dataflow_edge", flattenDataflowEdges(output.DataflowEdges)); err != nil {
+		return diag.Errorf("setting dataflow_edge: %s", err)
+	}
+	d.Set("minimum_viable_contact_duration_seconds", output.MinimumViableContactDurationSeconds)
+	d.Set("mission_profile_id", output.MissionProfileId)
+	d.Set("name", output.Name)
+	d.Set("tracking_config_arn", output.TrackingConfigArn)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMissionProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &groundstation.UpdateMissionProfileInput{
+			DataflowEdges:                       expandDataflowEdges(d.Get("dataflow_edge").([]interface{})),
+			MinimumViableContactDurationSeconds: aws.Int64(int64(d.Get("minimum_viable_contact_duration_seconds").(int))),
+			MissionProfileId:                    aws.String(d.Id()),
+			Name:                                aws.String(d.Get("name").(string)),
+			TrackingConfigArn:                   aws.String(d.Get("tracking_config_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("contact_post_pass_duration_seconds"); ok {
+			input.ContactPostPassDurationSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("contact_pre_pass_duration_seconds"); ok {
+			input.ContactPrePassDurationSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Ground Station Mission Profile: %s", input)
+		_, err := conn.UpdateMissionProfileWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Ground Station Mission Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Ground Station Mission Profile (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMissionProfileRead(ctx, d, meta)
+}
+
+func resourceMissionProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GroundStationConn
+
+	log.Printf("[DEBUG] Deleting Ground Station Mission Profile: %s", d.Id())
+	_, err := conn.DeleteMissionProfileWithContext(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, groundstation.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Ground Station Mission Profile (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataflowEdges(tfList []interface{}) [][]*string {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects [][]*string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, []*string{
+			aws.String(tfMap["source"].(string)),
+			aws.String(tfMap["destination"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDataflowEdges(apiObjects [][]*string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if len(apiObject) != 2 {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"destination": aws.StringValue(apiObject[1]),
+			"source":      aws.StringValue(apiObject[0]),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,170 @@
+//go:build sweep
+// +build sweep
+
+package groundstation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_groundstation_config", &resource.Sweeper{
+		Name: "aws_groundstation_config",
+		F:    sweepConfigs,
+		Dependencies: []string{
+			"aws_groundstation_mission_profile",
+		},
+	})
+
+	resource.AddTestSweepers("aws_groundstation_dataflow_endpoint_group", &resource.Sweeper{
+		Name: "aws_groundstation_dataflow_endpoint_group",
+		F:    sweepDataflowEndpointGroups,
+		Dependencies: []string{
+			"aws_groundstation_config",
+		},
+	})
+
+	resource.AddTestSweepers("aws_groundstation_mission_profile", &resource.Sweeper{
+		Name: "aws_groundstation_mission_profile",
+		F:    sweepMissionProfiles,
+	})
+}
+
+func sweepConfigs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).GroundStationConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListConfigsPages(&groundstation.ListConfigsInput{}, func(page *groundstation.ListConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfigList {
+			r := ResourceConfig()
+			d := r.Data(nil)
+			d.SetId(ConfigCreateResourceID(aws.StringValue(v.ConfigId), aws.StringValue(v.ConfigType)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Ground Station Config sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Ground Station Configs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Ground Station Configs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDataflowEndpointGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).GroundStationConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListDataflowEndpointGroupsPages(&groundstation.ListDataflowEndpointGroupsInput{}, func(page *groundstation.ListDataflowEndpointGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataflowEndpointGroupList {
+			r := ResourceDataflowEndpointGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DataflowEndpointGroupId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Ground Station Dataflow Endpoint Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Ground Station Dataflow Endpoint Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Ground Station Dataflow Endpoint Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepMissionProfiles(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).GroundStationConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListMissionProfilesPages(&groundstation.ListMissionProfilesInput{}, func(page *groundstation.ListMissionProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MissionProfileList {
+			r := ResourceMissionProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MissionProfileId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Ground Station Mission Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Ground Station Mission Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Ground Station Mission Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
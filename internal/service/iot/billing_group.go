@@ -0,0 +1,258 @@
+package iot
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBillingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBillingGroupCreate,
+		ReadWithoutTimeout:   resourceBillingGroupRead,
+		UpdateWithoutTimeout: resourceBillingGroupUpdate,
+		DeleteWithoutTimeout: resourceBillingGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBillingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iot.CreateBillingGroupInput{
+		BillingGroupName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BillingGroupProperties = expandBillingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Billing Group: %s", input)
+	output, err := conn.CreateBillingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Billing Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BillingGroupName))
+
+	return resourceBillingGroupRead(ctx, d, meta)
+}
+
+func resourceBillingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindBillingGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Billing Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Billing Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.BillingGroupArn)
+	d.Set("name", output.BillingGroupName)
+
+	if output.BillingGroupMetadata != nil {
+		if err := d.Set("metadata", []interface{}{flattenBillingGroupMetadata(output.BillingGroupMetadata)}); err != nil {
+			return diag.Errorf("setting metadata: %s", err)
+		}
+	} else {
+		d.Set("metadata", nil)
+	}
+	if v := flattenBillingGroupProperties(output.BillingGroupProperties); len(v) > 0 {
+		if err := d.Set("properties", []interface{}{v}); err != nil {
+			return diag.Errorf("setting properties: %s", err)
+		}
+	} else {
+		d.Set("properties", nil)
+	}
+	d.Set("version", output.Version)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT Billing Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBillingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iot.UpdateBillingGroupInput{
+			BillingGroupName: aws.String(d.Id()),
+			ExpectedVersion:  aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		if v, ok := d.GetOk("properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.BillingGroupProperties = expandBillingGroupProperties(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.BillingGroupProperties = &iot.BillingGroupProperties{}
+		}
+
+		log.Printf("[DEBUG] Updating IoT Billing Group: %s", input)
+		_, err := conn.UpdateBillingGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Billing Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT Billing Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBillingGroupRead(ctx, d, meta)
+}
+
+func resourceBillingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	log.Printf("[DEBUG] Deleting IoT Billing Group: %s", d.Id())
+	_, err := conn.DeleteBillingGroupWithContext(ctx, &iot.DeleteBillingGroupInput{
+		BillingGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Billing Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBillingGroupProperties(tfMap map[string]interface{}) *iot.BillingGroupProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.BillingGroupProperties{}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.BillingGroupDescription = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBillingGroupMetadata(apiObject *iot.BillingGroupMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CreationDate; v != nil {
+		tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
+func flattenBillingGroupProperties(apiObject *iot.BillingGroupProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BillingGroupDescription; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
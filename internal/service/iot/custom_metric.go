@@ -0,0 +1,178 @@
+package iot
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomMetric() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomMetricCreate,
+		ReadWithoutTimeout:   resourceCustomMetricRead,
+		UpdateWithoutTimeout: resourceCustomMetricUpdate,
+		DeleteWithoutTimeout: resourceCustomMetricDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"metric_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iot.CustomMetricType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomMetricCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("metric_name").(string)
+	input := &iot.CreateCustomMetricInput{
+		MetricName: aws.String(name),
+		MetricType: aws.String(d.Get("metric_type").(string)),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Custom Metric: %s", input)
+	output, err := conn.CreateCustomMetricWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT Custom Metric (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MetricName))
+
+	return resourceCustomMetricRead(ctx, d, meta)
+}
+
+func resourceCustomMetricRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindCustomMetricByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Custom Metric (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT Custom Metric (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.MetricArn)
+	d.Set("display_name", output.DisplayName)
+	d.Set("metric_name", output.MetricName)
+	d.Set("metric_type", output.MetricType)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT Custom Metric (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCustomMetricUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	if d.HasChange("display_name") {
+		input := &iot.UpdateCustomMetricInput{
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			MetricName:  aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Custom Metric: %s", input)
+		_, err := conn.UpdateCustomMetricWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT Custom Metric (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT Custom Metric (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCustomMetricRead(ctx, d, meta)
+}
+
+func resourceCustomMetricDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTConn
+
+	log.Printf("[DEBUG] Deleting IoT Custom Metric: %s", d.Id())
+	_, err := conn.DeleteCustomMetricWithContext(ctx, &iot.DeleteCustomMetricInput{
+		MetricName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT Custom Metric (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTCustomMetric_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_custom_metric.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomMetricConfig_basic(rName, "number"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomMetricExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("custommetric/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "metric_type", "number"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTCustomMetric_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_custom_metric.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomMetricConfig_basic(rName, "number"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomMetricExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiot.ResourceCustomMetric(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTCustomMetric_displayName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_custom_metric.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCustomMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomMetricConfig_displayName(rName, "display name 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display name 1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_type", "string-list"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomMetricConfig_displayName(rName, "display name 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display name 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomMetricExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Custom Metric ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+		_, err := tfiot.FindCustomMetricByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCustomMetricDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_custom_metric" {
+			continue
+		}
+
+		_, err := tfiot.FindCustomMetricByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Custom Metric %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCustomMetricConfig_basic(rName, metricType string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_custom_metric" "test" {
+  metric_name = %[1]q
+  metric_type = %[2]q
+}
+`, rName, metricType)
+}
+
+func testAccCustomMetricConfig_displayName(rName, displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_custom_metric" "test" {
+  metric_name  = %[1]q
+  metric_type  = "string-list"
+  display_name = %[2]q
+}
+`, rName, displayName)
+}
@@ -0,0 +1,269 @@
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Fleet metrics require fleet indexing, which is configured per account and Region,
+// so these tests must not run in parallel with the IoT Indexing Configuration tests.
+func TestAccIoTFleetMetric_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":                  testAccFleetMetric_basic,
+		"disappears":             testAccFleetMetric_disappears,
+		"update":                 testAccFleetMetric_update,
+		"invalidAggregationType": testAccFleetMetric_invalidAggregationType,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccFleetMetric_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_field", "registry.version"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Statistics"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.0", "sum"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iot", regexp.MustCompile(fmt.Sprintf("fleetmetric/%s$", rName))),
+					acctest.CheckResourceAttrRFC3339(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "index_name", "AWS_Things"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "thingName:*"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_version"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiot.ResourceFleetMetric(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_fleet_metric.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetMetricConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Statistics"),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+				),
+			},
+			{
+				Config: testAccFleetMetricConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetMetricExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_field", "registry.version"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.name", "Percentiles"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.0", "50"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_type.0.values.1", "90"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "period", "120"),
+					resource.TestCheckResourceAttr(resourceName, "unit", "Count"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFleetMetric_invalidAggregationType(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iot.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFleetMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetMetricConfig_aggregationType(rName, "Cardinality", `["sum"]`),
+				ExpectError: regexp.MustCompile(`aggregation_type.0.values must not be set`),
+			},
+			{
+				Config:      testAccFleetMetricConfig_aggregationType(rName, "Statistics", `["median"]`),
+				ExpectError: regexp.MustCompile(`aggregation_type.0.values must be one of`),
+			},
+			{
+				Config:      testAccFleetMetricConfig_aggregationType(rName, "Percentiles", `["101"]`),
+				ExpectError: regexp.MustCompile(`aggregation_type.0.values must be percentiles between 0 and 100`),
+			},
+		},
+	})
+}
+
+func testAccCheckFleetMetricExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Fleet Metric ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+		_, err := tfiot.FindFleetMetricByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckFleetMetricDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iot_fleet_metric" {
+			continue
+		}
+
+		_, err := tfiot.FindFleetMetricByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Fleet Metric %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+const testAccFleetMetricConfig_base = `
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+`
+
+func testAccFleetMetricConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetMetricConfig_base, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  query_string      = "thingName:*"
+  aggregation_field = "registry.version"
+  period            = 60
+
+  aggregation_type {
+    name   = "Statistics"
+    values = ["sum"]
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
+
+func testAccFleetMetricConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccFleetMetricConfig_base, fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  query_string      = "thingName:*"
+  aggregation_field = "registry.version"
+  period            = 120
+  description       = "updated"
+  unit              = "Count"
+
+  aggregation_type {
+    name   = "Percentiles"
+    values = ["50", "90"]
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName))
+}
+
+func testAccFleetMetricConfig_aggregationType(rName, aggregationTypeName, aggregationTypeValues string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_fleet_metric" "test" {
+  metric_name       = %[1]q
+  query_string      = "thingName:*"
+  aggregation_field = "registry.version"
+  period            = 60
+
+  aggregation_type {
+    name   = %[2]q
+    values = %[3]s
+  }
+}
+`, rName, aggregationTypeName, aggregationTypeValues)
+}
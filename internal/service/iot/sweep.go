@@ -17,6 +17,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_iot_billing_group", &resource.Sweeper{
+		Name: "aws_iot_billing_group",
+		F:    sweepBillingGroups,
+	})
+
 	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertifcates,
@@ -26,6 +31,16 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_iot_custom_metric", &resource.Sweeper{
+		Name: "aws_iot_custom_metric",
+		F:    sweepCustomMetrics,
+	})
+
+	resource.AddTestSweepers("aws_iot_fleet_metric", &resource.Sweeper{
+		Name: "aws_iot_fleet_metric",
+		F:    sweepFleetMetrics,
+	})
+
 	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
@@ -572,3 +587,132 @@ func sweepTopicRuleDestinations(region string) error {
 
 	return nil
 }
+
+func sweepBillingGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTConn
+	input := &iot.ListBillingGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListBillingGroupsPages(input, func(page *iot.ListBillingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BillingGroups {
+			r := ResourceBillingGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.GroupName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Billing Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Billing Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Billing Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCustomMetrics(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTConn
+	input := &iot.ListCustomMetricsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCustomMetricsPages(input, func(page *iot.ListCustomMetricsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MetricNames {
+			r := ResourceCustomMetric()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Custom Metric sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Custom Metrics (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Custom Metrics (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFleetMetrics(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTConn
+	input := &iot.ListFleetMetricsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListFleetMetricsPages(input, func(page *iot.ListFleetMetricsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FleetMetrics {
+			r := ResourceFleetMetric()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MetricName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Fleet Metric sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	return nil
+}
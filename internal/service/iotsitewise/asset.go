@@ -0,0 +1,387 @@
+package iotsitewise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAsset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetCreate,
+		ReadWithoutTimeout:   resourceAssetRead,
+		UpdateWithoutTimeout: resourceAssetUpdate,
+		DeleteWithoutTimeout: resourceAssetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"property_alias": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"property_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotsitewise.CreateAssetInput{
+		AssetModelId: aws.String(d.Get("asset_model_id").(string)),
+		AssetName:    aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.AssetDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT SiteWise Asset: %s", input)
+	output, err := conn.CreateAssetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT SiteWise Asset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetId))
+
+	asset, err := waitAssetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return diag.Errorf("waiting for IoT SiteWise Asset (%s) create: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("property_alias"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateAssetPropertyAliases(ctx, conn, asset, expandPropertyAliases(v.(*schema.Set).List()), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("updating IoT SiteWise Asset (%s) property aliases: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAssetRead(ctx, d, meta)
+}
+
+func resourceAssetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAssetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.AssetArn)
+	d.Set("asset_model_id", output.AssetModelId)
+	d.Set("description", output.AssetDescription)
+	d.Set("name", output.AssetName)
+	if err := d.Set("properties", flattenAssetProperties(output.AssetProperties)); err != nil {
+		return diag.Errorf("setting properties: %s", err)
+	}
+	if err := d.Set("property_alias", flattenPropertyAliases(output.AssetProperties)); err != nil {
+		return diag.Errorf("setting property_alias: %s", err)
+	}
+
+	tags, err := ListTags(conn, aws.StringValue(output.AssetArn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAssetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+
+	if d.HasChanges("description", "name") {
+		input := &iotsitewise.UpdateAssetInput{
+			AssetId:   aws.String(d.Id()),
+			AssetName: aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.AssetDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT SiteWise Asset: %s", input)
+		_, err := conn.UpdateAssetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT SiteWise Asset (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("property_alias") {
+		asset, err := FindAssetByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if err := updateAssetPropertyAliases(ctx, conn, asset, expandPropertyAliases(d.Get("property_alias").(*schema.Set).List()), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating IoT SiteWise Asset (%s) property aliases: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT SiteWise Asset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAssetRead(ctx, d, meta)
+}
+
+func resourceAssetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset: %s", d.Id())
+	_, err := conn.DeleteAssetWithContext(ctx, &iotsitewise.DeleteAssetInput{
+		AssetId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for IoT SiteWise Asset (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateAssetPropertyAliases sets the alias of each of the asset's properties to the one configured, keyed by property name.
+// Properties without a configured alias have any existing alias removed.
+// UpdateAssetProperty resets the notification state if it is omitted, so the current state is sent back unchanged.
+func updateAssetPropertyAliases(ctx context.Context, conn *iotsitewise.IoTSiteWise, asset *iotsitewise.DescribeAssetOutput, aliases map[string]string, timeout time.Duration) error {
+	id := aws.StringValue(asset.AssetId)
+	names := make(map[string]struct{})
+
+	for _, property := range asset.AssetProperties {
+		if property == nil {
+			continue
+		}
+
+		names[aws.StringValue(property.Name)] = struct{}{}
+	}
+
+	for name := range aliases {
+		if _, ok := names[name]; !ok {
+			return fmt.Errorf("property (%s) not found", name)
+		}
+	}
+
+	for _, property := range asset.AssetProperties {
+		if property == nil {
+			continue
+		}
+
+		name := aws.StringValue(property.Name)
+		alias := aliases[name]
+
+		if alias == aws.StringValue(property.Alias) {
+			continue
+		}
+
+		input := &iotsitewise.UpdateAssetPropertyInput{
+			AssetId:    aws.String(id),
+			PropertyId: property.Id,
+		}
+
+		if alias != "" {
+			input.PropertyAlias = aws.String(alias)
+		}
+
+		if v := property.Notification; v != nil {
+			input.PropertyNotificationState = v.State
+		}
+
+		log.Printf("[DEBUG] Updating IoT SiteWise Asset Property: %s", input)
+		_, err := conn.UpdateAssetPropertyWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("updating property (%s): %w", name, err)
+		}
+
+		if _, err := waitAssetUpdated(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for property (%s) update: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandPropertyAliases(tfList []interface{}) map[string]string {
+	aliases := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		aliases[tfMap["property_name"].(string)] = tfMap["alias"].(string)
+	}
+
+	return aliases
+}
+
+func flattenAssetProperties(apiObjects []*iotsitewise.AssetProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"alias":     aws.StringValue(apiObject.Alias),
+			"data_type": aws.StringValue(apiObject.DataType),
+			"id":        aws.StringValue(apiObject.Id),
+			"name":      aws.StringValue(apiObject.Name),
+			"unit":      aws.StringValue(apiObject.Unit),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPropertyAliases(apiObjects []*iotsitewise.AssetProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Alias == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"alias":         aws.StringValue(apiObject.Alias),
+			"property_name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
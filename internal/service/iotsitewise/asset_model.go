@@ -0,0 +1,936 @@
+package iotsitewise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssetModel() *schema.Resource {
+	variableSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hierarchy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9_]*$`), "must start with a lowercase letter and contain only lowercase letters, numbers and underscores"),
+				),
+			},
+			"property_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetModelCreate,
+		ReadWithoutTimeout:   resourceAssetModelRead,
+		UpdateWithoutTimeout: resourceAssetModelUpdate,
+		DeleteWithoutTimeout: resourceAssetModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"hierarchy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_asset_model_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"property": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+						"data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iotsitewise.PropertyDataType_Values(), false),
+						},
+						"data_type_spec": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"measurement": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"forwarding_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(iotsitewise.ForwardingConfigState_Values(), false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compute_location": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+									},
+									"expression": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     variableSchema,
+									},
+									"window": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tumbling": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"interval": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(2, 23),
+															},
+															"offset": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(2, 25),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"transform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compute_location": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+									},
+									"expression": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"forwarding_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(iotsitewise.ForwardingConfigState_Values(), false),
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     variableSchema,
+									},
+								},
+							},
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceAssetModelCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceAssetModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotsitewise.CreateAssetModelInput{
+		AssetModelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.AssetModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hierarchy"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelHierarchies = expandAssetModelHierarchyDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("property"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelProperties = expandAssetModelPropertyDefinitions(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT SiteWise Asset Model: %s", input)
+	output, err := conn.CreateAssetModelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT SiteWise Asset Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetModelId))
+
+	if _, err := waitAssetModelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for IoT SiteWise Asset Model (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAssetModelRead(ctx, d, meta)
+}
+
+func resourceAssetModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAssetModelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.AssetModelArn)
+	d.Set("description", output.AssetModelDescription)
+	if err := d.Set("hierarchy", flattenAssetModelHierarchies(assetModelHierarchiesInConfiguredOrder(output.AssetModelHierarchies, d))); err != nil {
+		return diag.Errorf("setting hierarchy: %s", err)
+	}
+	d.Set("name", output.AssetModelName)
+	if err := d.Set("property", flattenAssetModelProperties(assetModelPropertiesInConfiguredOrder(output.AssetModelProperties, d), newAssetModelNameResolver(output))); err != nil {
+		return diag.Errorf("setting property: %s", err)
+	}
+
+	tags, err := ListTags(conn, aws.StringValue(output.AssetModelArn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAssetModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		current, err := FindAssetModelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		input := &iotsitewise.UpdateAssetModelInput{
+			// Composite models are not managed by this resource. Send back the existing ones so that they are not removed.
+			AssetModelCompositeModels: current.AssetModelCompositeModels,
+			AssetModelId:              aws.String(d.Id()),
+			AssetModelName:            aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.AssetModelDescription = aws.String(v.(string))
+		}
+
+		input.AssetModelHierarchies = expandAssetModelHierarchies(d.Get("hierarchy").([]interface{}), current.AssetModelHierarchies)
+		input.AssetModelProperties = expandAssetModelProperties(d.Get("property").([]interface{}), current.AssetModelProperties)
+
+		log.Printf("[DEBUG] Updating IoT SiteWise Asset Model: %s", input)
+		_, err = conn.UpdateAssetModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetModelUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT SiteWise Asset Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT SiteWise Asset Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAssetModelRead(ctx, d, meta)
+}
+
+func resourceAssetModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset Model: %s", d.Id())
+	_, err := conn.DeleteAssetModelWithContext(ctx, &iotsitewise.DeleteAssetModelInput{
+		AssetModelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for IoT SiteWise Asset Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAssetModelCustomizeDiff ensures that each property has exactly one property type block.
+func resourceAssetModelCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("property") {
+		return nil
+	}
+
+	for i, tfMapRaw := range diff.Get("property").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		n := 0
+
+		for _, k := range []string{"attribute", "measurement", "metric", "transform"} {
+			if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+				n++
+			}
+		}
+
+		if n != 1 {
+			return fmt.Errorf("property.%d: exactly one of attribute, measurement, metric or transform must be configured", i)
+		}
+	}
+
+	return nil
+}
+
+// assetModelNameResolver maps the IDs of this asset model's properties and hierarchies back to their names.
+// Transform and metric variables may reference properties and hierarchies of the same asset model by name,
+// but the API always returns IDs.
+type assetModelNameResolver struct {
+	hierarchyNames map[string]string
+	propertyNames  map[string]string
+}
+
+func newAssetModelNameResolver(output *iotsitewise.DescribeAssetModelOutput) *assetModelNameResolver {
+	r := &assetModelNameResolver{
+		hierarchyNames: make(map[string]string),
+		propertyNames:  make(map[string]string),
+	}
+
+	for _, v := range output.AssetModelHierarchies {
+		if v == nil {
+			continue
+		}
+
+		r.hierarchyNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+
+	for _, v := range output.AssetModelProperties {
+		if v == nil {
+			continue
+		}
+
+		r.propertyNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+
+	return r
+}
+
+func (r *assetModelNameResolver) hierarchyName(id string) string {
+	if v, ok := r.hierarchyNames[id]; ok {
+		return v
+	}
+
+	return id
+}
+
+func (r *assetModelNameResolver) propertyName(id string) string {
+	if v, ok := r.propertyNames[id]; ok {
+		return v
+	}
+
+	return id
+}
+
+// assetModelHierarchiesInConfiguredOrder returns the hierarchies ordered as they are configured,
+// followed by any that are not configured. The API does not preserve ordering.
+func assetModelHierarchiesInConfiguredOrder(apiObjects []*iotsitewise.AssetModelHierarchy, d *schema.ResourceData) []*iotsitewise.AssetModelHierarchy {
+	var names []string
+
+	for _, tfMapRaw := range d.Get("hierarchy").([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			names = append(names, tfMap["name"].(string))
+		}
+	}
+
+	byName := make(map[string]*iotsitewise.AssetModelHierarchy)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		byName[aws.StringValue(apiObject.Name)] = apiObject
+	}
+
+	var ordered []*iotsitewise.AssetModelHierarchy
+
+	for _, name := range names {
+		if apiObject, ok := byName[name]; ok {
+			ordered = append(ordered, apiObject)
+			delete(byName, name)
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if _, ok := byName[aws.StringValue(apiObject.Name)]; ok {
+			ordered = append(ordered, apiObject)
+		}
+	}
+
+	return ordered
+}
+
+// assetModelPropertiesInConfiguredOrder returns the properties ordered as they are configured,
+// followed by any that are not configured. The API does not preserve ordering.
+func assetModelPropertiesInConfiguredOrder(apiObjects []*iotsitewise.AssetModelProperty, d *schema.ResourceData) []*iotsitewise.AssetModelProperty {
+	var names []string
+
+	for _, tfMapRaw := range d.Get("property").([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			names = append(names, tfMap["name"].(string))
+		}
+	}
+
+	byName := make(map[string]*iotsitewise.AssetModelProperty)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		byName[aws.StringValue(apiObject.Name)] = apiObject
+	}
+
+	var ordered []*iotsitewise.AssetModelProperty
+
+	for _, name := range names {
+		if apiObject, ok := byName[name]; ok {
+			ordered = append(ordered, apiObject)
+			delete(byName, name)
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if _, ok := byName[aws.StringValue(apiObject.Name)]; ok {
+			ordered = append(ordered, apiObject)
+		}
+	}
+
+	return ordered
+}
+
+func expandAssetModelHierarchyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelHierarchyDefinition {
+	var apiObjects []*iotsitewise.AssetModelHierarchyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iotsitewise.AssetModelHierarchyDefinition{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Name:              aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// expandAssetModelHierarchies expands the configured hierarchies for update.
+// Existing hierarchies are matched by name so that their IDs are retained; unmatched hierarchies are created.
+func expandAssetModelHierarchies(tfList []interface{}, current []*iotsitewise.AssetModelHierarchy) []*iotsitewise.AssetModelHierarchy {
+	ids := make(map[string]*string)
+
+	for _, v := range current {
+		if v == nil {
+			continue
+		}
+
+		ids[aws.StringValue(v.Name)] = v.Id
+	}
+
+	apiObjects := make([]*iotsitewise.AssetModelHierarchy, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		apiObjects = append(apiObjects, &iotsitewise.AssetModelHierarchy{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Id:                ids[name],
+			Name:              aws.String(name),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelPropertyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelPropertyDefinition {
+	var apiObjects []*iotsitewise.AssetModelPropertyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelPropertyDefinition{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Name:     aws.String(tfMap["name"].(string)),
+			Type:     expandPropertyType(tfMap),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if v, ok := tfMap["unit"].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandAssetModelProperties expands the configured properties for update.
+// Existing properties are matched by name so that their IDs, and therefore their data, are retained;
+// unmatched properties are created.
+func expandAssetModelProperties(tfList []interface{}, current []*iotsitewise.AssetModelProperty) []*iotsitewise.AssetModelProperty {
+	ids := make(map[string]*string)
+
+	for _, v := range current {
+		if v == nil {
+			continue
+		}
+
+		ids[aws.StringValue(v.Name)] = v.Id
+	}
+
+	apiObjects := make([]*iotsitewise.AssetModelProperty, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &iotsitewise.AssetModelProperty{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Id:       ids[name],
+			Name:     aws.String(name),
+			Type:     expandPropertyType(tfMap),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if v, ok := tfMap["unit"].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPropertyType(tfMap map[string]interface{}) *iotsitewise.PropertyType {
+	apiObject := &iotsitewise.PropertyType{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Attribute = &iotsitewise.Attribute{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["default_value"].(string); ok && v != "" {
+				apiObject.Attribute.DefaultValue = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["measurement"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Measurement = &iotsitewise.Measurement{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["forwarding_state"].(string); ok && v != "" {
+				apiObject.Measurement.ProcessingConfig = &iotsitewise.MeasurementProcessingConfig{
+					ForwardingConfig: &iotsitewise.ForwardingConfig{
+						State: aws.String(v),
+					},
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Metric = &iotsitewise.Metric{
+			Expression: aws.String(tfMap["expression"].(string)),
+			Variables:  expandExpressionVariables(tfMap["variable"].([]interface{})),
+		}
+
+		if v, ok := tfMap["compute_location"].(string); ok && v != "" {
+			apiObject.Metric.ProcessingConfig = &iotsitewise.MetricProcessingConfig{
+				ComputeLocation: aws.String(v),
+			}
+		}
+
+		if v, ok := tfMap["window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Metric.Window = expandMetricWindow(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["transform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Transform = &iotsitewise.Transform{
+			Expression: aws.String(tfMap["expression"].(string)),
+			Variables:  expandExpressionVariables(tfMap["variable"].([]interface{})),
+		}
+
+		if v, ok := tfMap["compute_location"].(string); ok && v != "" {
+			apiObject.Transform.ProcessingConfig = &iotsitewise.TransformProcessingConfig{
+				ComputeLocation: aws.String(v),
+			}
+
+			if v, ok := tfMap["forwarding_state"].(string); ok && v != "" {
+				apiObject.Transform.ProcessingConfig.ForwardingConfig = &iotsitewise.ForwardingConfig{
+					State: aws.String(v),
+				}
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandExpressionVariables(tfList []interface{}) []*iotsitewise.ExpressionVariable {
+	apiObjects := make([]*iotsitewise.ExpressionVariable, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.ExpressionVariable{
+			Name: aws.String(tfMap["name"].(string)),
+			Value: &iotsitewise.VariableValue{
+				PropertyId: aws.String(tfMap["property_id"].(string)),
+			},
+		}
+
+		if v, ok := tfMap["hierarchy_id"].(string); ok && v != "" {
+			apiObject.Value.HierarchyId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricWindow(tfMap map[string]interface{}) *iotsitewise.MetricWindow {
+	apiObject := &iotsitewise.MetricWindow{}
+
+	if v, ok := tfMap["tumbling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Tumbling = &iotsitewise.TumblingWindow{
+			Interval: aws.String(tfMap["interval"].(string)),
+		}
+
+		if v, ok := tfMap["offset"].(string); ok && v != "" {
+			apiObject.Tumbling.Offset = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAssetModelHierarchies(apiObjects []*iotsitewise.AssetModelHierarchy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"child_asset_model_id": aws.StringValue(apiObject.ChildAssetModelId),
+			"id":                   aws.StringValue(apiObject.Id),
+			"name":                 aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAssetModelProperties(apiObjects []*iotsitewise.AssetModelProperty, r *assetModelNameResolver) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"data_type":      aws.StringValue(apiObject.DataType),
+			"data_type_spec": aws.StringValue(apiObject.DataTypeSpec),
+			"id":             aws.StringValue(apiObject.Id),
+			"name":           aws.StringValue(apiObject.Name),
+			"unit":           aws.StringValue(apiObject.Unit),
+		}
+
+		if v := apiObject.Type; v != nil {
+			if v := v.Attribute; v != nil {
+				tfMap["attribute"] = []interface{}{map[string]interface{}{
+					"default_value": aws.StringValue(v.DefaultValue),
+				}}
+			}
+
+			if v := v.Measurement; v != nil {
+				m := map[string]interface{}{}
+
+				if v := v.ProcessingConfig; v != nil && v.ForwardingConfig != nil {
+					m["forwarding_state"] = aws.StringValue(v.ForwardingConfig.State)
+				}
+
+				tfMap["measurement"] = []interface{}{m}
+			}
+
+			if v := v.Metric; v != nil {
+				m := map[string]interface{}{
+					"expression": aws.StringValue(v.Expression),
+					"variable":   flattenExpressionVariables(v.Variables, r),
+				}
+
+				if v := v.ProcessingConfig; v != nil {
+					m["compute_location"] = aws.StringValue(v.ComputeLocation)
+				}
+
+				if v := v.Window; v != nil && v.Tumbling != nil {
+					m["window"] = []interface{}{map[string]interface{}{
+						"tumbling": []interface{}{map[string]interface{}{
+							"interval": aws.StringValue(v.Tumbling.Interval),
+							"offset":   aws.StringValue(v.Tumbling.Offset),
+						}},
+					}}
+				}
+
+				tfMap["metric"] = []interface{}{m}
+			}
+
+			if v := v.Transform; v != nil {
+				m := map[string]interface{}{
+					"expression": aws.StringValue(v.Expression),
+					"variable":   flattenExpressionVariables(v.Variables, r),
+				}
+
+				if v := v.ProcessingConfig; v != nil {
+					m["compute_location"] = aws.StringValue(v.ComputeLocation)
+
+					if v := v.ForwardingConfig; v != nil {
+						m["forwarding_state"] = aws.StringValue(v.State)
+					}
+				}
+
+				tfMap["transform"] = []interface{}{m}
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenExpressionVariables(apiObjects []*iotsitewise.ExpressionVariable, r *assetModelNameResolver) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Value; v != nil {
+			if v.HierarchyId != nil {
+				// Properties reached through a hierarchy belong to the child asset model and keep their IDs.
+				tfMap["hierarchy_id"] = r.hierarchyName(aws.StringValue(v.HierarchyId))
+				tfMap["property_id"] = aws.StringValue(v.PropertyId)
+			} else {
+				tfMap["property_id"] = r.propertyName(aws.StringValue(v.PropertyId))
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
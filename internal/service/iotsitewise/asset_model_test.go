@@ -0,0 +1,407 @@
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWiseAssetModel_basic(t *testing.T) {
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`asset-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_disappears(t *testing.T) {
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotsitewise.ResourceAssetModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_tags(t *testing.T) {
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAssetModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_properties(t *testing.T) {
+	var v1, v2 iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_properties(rName, "Celsius"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.name", "serial_number"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.data_type", iotsitewise.PropertyDataTypeString),
+					resource.TestCheckResourceAttr(resourceName, "property.0.attribute.0.default_value", "unknown"),
+					resource.TestCheckResourceAttr(resourceName, "property.1.name", "temperature_c"),
+					resource.TestCheckResourceAttr(resourceName, "property.1.measurement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "property.1.unit", "Celsius"),
+					resource.TestCheckResourceAttr(resourceName, "property.2.name", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "property.2.transform.0.expression", "temp_c * 9 / 5 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "property.2.transform.0.variable.0.name", "temp_c"),
+					resource.TestCheckResourceAttr(resourceName, "property.2.transform.0.variable.0.property_id", "temperature_c"),
+					resource.TestCheckResourceAttr(resourceName, "property.3.name", "max_temperature_c"),
+					resource.TestCheckResourceAttr(resourceName, "property.3.metric.0.expression", "max(temp_c)"),
+					resource.TestCheckResourceAttr(resourceName, "property.3.metric.0.window.0.tumbling.0.interval", "1h"),
+					resource.TestCheckResourceAttrSet(resourceName, "property.1.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_properties(rName, "Degrees Celsius"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v2),
+					testAccCheckAssetModelPropertyIDRetained(&v1, &v2, "temperature_c"),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "property.1.unit", "Degrees Celsius"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_hierarchy(t *testing.T) {
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	childResourceName := "aws_iotsitewise_asset_model.child"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_hierarchy(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "hierarchy.0.child_asset_model_id", childResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "hierarchy.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.0.name", "sensors"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.metric.0.variable.0.hierarchy_id", "sensors"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotsitewise_asset_model" {
+			continue
+		}
+
+		_, err := tfiotsitewise.FindAssetModelByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT SiteWise Asset Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAssetModelExists(n string, v *iotsitewise.DescribeAssetModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Asset Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn
+
+		output, err := tfiotsitewise.FindAssetModelByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetModelPropertyIDRetained(before, after *iotsitewise.DescribeAssetModelOutput, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := func(v *iotsitewise.DescribeAssetModelOutput) string {
+			for _, p := range v.AssetModelProperties {
+				if p.Name != nil && *p.Name == name {
+					return *p.Id
+				}
+			}
+
+			return ""
+		}
+
+		if b, a := id(before), id(after); b == "" || b != a {
+			return fmt.Errorf("IoT SiteWise Asset Model property (%s) ID changed from %q to %q", name, b, a)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetModelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAssetModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAssetModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAssetModelConfig_properties(rName, unit string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "serial_number"
+    data_type = "STRING"
+
+    attribute {
+      default_value = "unknown"
+    }
+  }
+
+  property {
+    name      = "temperature_c"
+    data_type = "DOUBLE"
+    unit      = %[2]q
+
+    measurement {}
+  }
+
+  property {
+    name      = "temperature_f"
+    data_type = "DOUBLE"
+    unit      = "Fahrenheit"
+
+    transform {
+      expression = "temp_c * 9 / 5 + 32"
+
+      variable {
+        name        = "temp_c"
+        property_id = "temperature_c"
+      }
+    }
+  }
+
+  property {
+    name      = "max_temperature_c"
+    data_type = "DOUBLE"
+    unit      = %[2]q
+
+    metric {
+      expression = "max(temp_c)"
+
+      variable {
+        name        = "temp_c"
+        property_id = "temperature_c"
+      }
+
+      window {
+        tumbling {
+          interval = "1h"
+        }
+      }
+    }
+  }
+}
+`, rName, unit)
+}
+
+func testAccAssetModelConfig_hierarchy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "child" {
+  name = "%[1]s-child"
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+
+    measurement {}
+  }
+}
+
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  hierarchy {
+    name                 = "sensors"
+    child_asset_model_id = aws_iotsitewise_asset_model.child.id
+  }
+
+  property {
+    name      = "average_temperature"
+    data_type = "DOUBLE"
+
+    metric {
+      expression = "avg(temp)"
+
+      variable {
+        name         = "temp"
+        hierarchy_id = "sensors"
+        property_id  = aws_iotsitewise_asset_model.child.property[0].id
+      }
+
+      window {
+        tumbling {
+          interval = "5m"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,270 @@
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWiseAsset_basic(t *testing.T) {
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	modelResourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`asset/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_id", modelResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "properties.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "property_alias.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_disappears(t *testing.T) {
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotsitewise.ResourceAsset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_description(t *testing.T) {
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_description(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_description(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_propertyAlias(t *testing.T) {
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_propertyAlias(rName, "/plant/line1/temperature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "property_alias.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_alias.*", map[string]string{
+						"alias":         "/plant/line1/temperature",
+						"property_name": "temperature",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "properties.*", map[string]string{
+						"alias": "/plant/line1/temperature",
+						"name":  "temperature",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_propertyAlias(rName, "/plant/line2/temperature"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "property_alias.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_alias.*", map[string]string{
+						"alias":         "/plant/line2/temperature",
+						"property_name": "temperature",
+					}),
+				),
+			},
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "property_alias.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "properties.*", map[string]string{
+						"alias": "",
+						"name":  "temperature",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotsitewise_asset" {
+			continue
+		}
+
+		_, err := tfiotsitewise.FindAssetByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT SiteWise Asset %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAssetExists(n string, v *iotsitewise.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Asset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn
+
+		output, err := tfiotsitewise.FindAssetByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAssetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "serial_number"
+    data_type = "STRING"
+
+    attribute {}
+  }
+
+  property {
+    name      = "temperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    measurement {}
+  }
+}
+`, rName)
+}
+
+func testAccAssetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAssetConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+`, rName))
+}
+
+func testAccAssetConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccAssetConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  description    = %[2]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+`, rName, description))
+}
+
+func testAccAssetConfig_propertyAlias(rName, alias string) string {
+	return acctest.ConfigCompose(testAccAssetConfigBase(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+
+  property_alias {
+    property_name = "temperature"
+    alias         = %[2]q
+  }
+}
+`, rName, alias))
+}
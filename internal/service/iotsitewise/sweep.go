@@ -0,0 +1,137 @@
+//go:build sweep
+// +build sweep
+
+package iotsitewise
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotsitewise_asset", &resource.Sweeper{
+		Name: "aws_iotsitewise_asset",
+		F:    sweepAssets,
+	})
+
+	resource.AddTestSweepers("aws_iotsitewise_asset_model", &resource.Sweeper{
+		Name: "aws_iotsitewise_asset_model",
+		F:    sweepAssetModels,
+		Dependencies: []string{
+			"aws_iotsitewise_asset",
+		},
+	})
+}
+
+func sweepAssets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTSiteWiseConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	// Listing all assets, rather than only top-level ones, requires an asset model ID.
+	err = conn.ListAssetModelsPages(&iotsitewise.ListAssetModelsInput{}, func(page *iotsitewise.ListAssetModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssetModelSummaries {
+			input := &iotsitewise.ListAssetsInput{
+				AssetModelId: v.Id,
+				Filter:       aws.String(iotsitewise.ListAssetsFilterAll),
+			}
+
+			err := conn.ListAssetsPages(input, func(page *iotsitewise.ListAssetsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.AssetSummaries {
+					r := ResourceAsset()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.Id))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing IoT SiteWise Assets for Asset Model (%s) (%s): %w", aws.StringValue(v.Id), region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT SiteWise Asset Models (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT SiteWise Assets (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IoT SiteWise Asset sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepAssetModels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTSiteWiseConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListAssetModelsPages(&iotsitewise.ListAssetModelsInput{}, func(page *iotsitewise.ListAssetModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssetModelSummaries {
+			r := ResourceAssetModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT SiteWise Asset Models (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT SiteWise Asset Models (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IoT SiteWise Asset Model sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
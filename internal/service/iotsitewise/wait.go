@@ -0,0 +1,147 @@
+package iotsitewise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitAssetCreated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateCreating},
+		Target:  []string{iotsitewise.AssetStateActive},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.AssetStatus.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetUpdated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateUpdating},
+		Target:  []string{iotsitewise.AssetStateActive},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.AssetStatus.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateDeleting},
+		Target:  []string{},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.AssetStatus.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelCreated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateCreating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.AssetModelStatus.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelUpdated(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateUpdating, iotsitewise.AssetModelStatePropagating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.AssetModelStatus.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateDeleting},
+		Target:  []string{},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		tfresource.SetLastError(err, errorDetailsError(output.AssetModelStatus.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func errorDetailsError(apiObject *iotsitewise.ErrorDetails) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Message)))
+
+	for _, v := range apiObject.Details {
+		if v == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+	}
+
+	return errs.ErrorOrNil()
+}
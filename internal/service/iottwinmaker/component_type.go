@@ -0,0 +1,735 @@
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceComponentType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentTypeCreate,
+		ReadWithoutTimeout:   resourceComponentTypeRead,
+		UpdateWithoutTimeout: resourceComponentTypeUpdate,
+		DeleteWithoutTimeout: resourceComponentTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_type_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"extends_from": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+			"function": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"implemented_by": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"is_native": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"required_properties": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(iottwinmaker.Scope_Values(), false),
+						},
+					},
+				},
+			},
+			"is_abstract": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_schema_initialized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_singleton": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"property_definition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"data_type": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"nested_type": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iottwinmaker.Type_Values(), false),
+												},
+												"unit_of_measure": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"relationship": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"relationship_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"target_component_type_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(iottwinmaker.Type_Values(), false),
+									},
+									"unit_of_measure": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"default_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"boolean_value": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"double_value": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+									"expression": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 316),
+									},
+									"integer_value": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"long_value": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"string_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"is_external_id": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_required_in_entity": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_stored_externally": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_time_series": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceComponentTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	workspaceID := d.Get("workspace_id").(string)
+	componentTypeID := d.Get("component_type_id").(string)
+	id := ComponentTypeCreateResourceID(workspaceID, componentTypeID)
+	input := &iottwinmaker.CreateComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("extends_from"); ok && len(v.([]interface{})) > 0 {
+		input.ExtendsFrom = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("function"); ok && v.(*schema.Set).Len() > 0 {
+		input.Functions = expandFunctionRequests(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("is_singleton"); ok {
+		input.IsSingleton = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("property_definition"); ok && v.(*schema.Set).Len() > 0 {
+		input.PropertyDefinitions = expandPropertyDefinitionRequests(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT TwinMaker Component Type: %s", input)
+	_, err := conn.CreateComponentTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT TwinMaker Component Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitComponentTypeCreated(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for IoT TwinMaker Component Type (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceComponentTypeRead(ctx, d, meta)
+}
+
+func resourceComponentTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	workspaceID, componentTypeID, err := ComponentTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Component Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("component_type_id", output.ComponentTypeId)
+	d.Set("creation_date_time", aws.TimeValue(output.CreationDateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("extends_from", aws.StringValueSlice(output.ExtendsFrom))
+	if err := d.Set("function", flattenFunctionResponses(output.Functions)); err != nil {
+		return diag.Errorf("setting function: %s", err)
+	}
+	d.Set("is_abstract", output.IsAbstract)
+	d.Set("is_schema_initialized", output.IsSchemaInitialized)
+	d.Set("is_singleton", output.IsSingleton)
+	if err := d.Set("property_definition", flattenPropertyDefinitionResponses(output.PropertyDefinitions)); err != nil {
+		return diag.Errorf("setting property_definition: %s", err)
+	}
+	d.Set("status", output.Status.State)
+	d.Set("update_date_time", aws.TimeValue(output.UpdateDateTime).Format(time.RFC3339))
+	d.Set("workspace_id", output.WorkspaceId)
+
+	tags, err := ListTags(conn, aws.StringValue(output.Arn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComponentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		workspaceID, componentTypeID, err := ComponentTypeParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &iottwinmaker.UpdateComponentTypeInput{
+			ComponentTypeId:     aws.String(componentTypeID),
+			Description:         aws.String(d.Get("description").(string)),
+			ExtendsFrom:         flex.ExpandStringList(d.Get("extends_from").([]interface{})),
+			Functions:           expandFunctionRequests(d.Get("function").(*schema.Set).List()),
+			IsSingleton:         aws.Bool(d.Get("is_singleton").(bool)),
+			PropertyDefinitions: expandPropertyDefinitionRequests(d.Get("property_definition").(*schema.Set).List()),
+			WorkspaceId:         aws.String(workspaceID),
+		}
+
+		log.Printf("[DEBUG] Updating IoT TwinMaker Component Type: %s", input)
+		_, err = conn.UpdateComponentTypeWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitComponentTypeUpdated(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for IoT TwinMaker Component Type (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT TwinMaker Component Type (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceComponentTypeRead(ctx, d, meta)
+}
+
+func resourceComponentTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+
+	workspaceID, componentTypeID, err := ComponentTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Component Type: %s", d.Id())
+	_, err = conn.DeleteComponentTypeWithContext(ctx, &iottwinmaker.DeleteComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitComponentTypeDeleted(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for IoT TwinMaker Component Type (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const componentTypeResourceIDSeparator = ","
+
+func ComponentTypeCreateResourceID(workspaceID, componentTypeID string) string {
+	parts := []string{workspaceID, componentTypeID}
+	id := strings.Join(parts, componentTypeResourceIDSeparator)
+
+	return id
+}
+
+func ComponentTypeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, componentTypeResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sCOMPONENT-TYPE-ID", id, componentTypeResourceIDSeparator)
+}
+
+func expandFunctionRequests(tfList []interface{}) map[string]*iottwinmaker.FunctionRequest {
+	apiObjects := make(map[string]*iottwinmaker.FunctionRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iottwinmaker.FunctionRequest{}
+
+		if v, ok := tfMap["implemented_by"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.ImplementedBy = &iottwinmaker.DataConnector{}
+
+			if v, ok := tfMap["is_native"].(bool); ok && v {
+				apiObject.ImplementedBy.IsNative = aws.Bool(v)
+			}
+
+			if v, ok := tfMap["lambda_arn"].(string); ok && v != "" {
+				apiObject.ImplementedBy.Lambda = &iottwinmaker.LambdaFunction{
+					Arn: aws.String(v),
+				}
+			}
+		}
+
+		if v, ok := tfMap["required_properties"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.RequiredProperties = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["scope"].(string); ok && v != "" {
+			apiObject.Scope = aws.String(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandPropertyDefinitionRequests(tfList []interface{}) map[string]*iottwinmaker.PropertyDefinitionRequest {
+	apiObjects := make(map[string]*iottwinmaker.PropertyDefinitionRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iottwinmaker.PropertyDefinitionRequest{
+			IsExternalId:       aws.Bool(tfMap["is_external_id"].(bool)),
+			IsRequiredInEntity: aws.Bool(tfMap["is_required_in_entity"].(bool)),
+			IsStoredExternally: aws.Bool(tfMap["is_stored_externally"].(bool)),
+			IsTimeSeries:       aws.Bool(tfMap["is_time_series"].(bool)),
+		}
+
+		if v, ok := tfMap["configuration"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Configuration = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["data_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DataType = expandDataType(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["default_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DefaultValue = expandDataValue(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDataType(tfMap map[string]interface{}) *iottwinmaker.DataType {
+	apiObject := &iottwinmaker.DataType{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["allowed_values"].([]interface{}); ok && len(v) > 0 {
+		for _, v := range v {
+			apiObject.AllowedValues = append(apiObject.AllowedValues, &iottwinmaker.DataValue{
+				StringValue: aws.String(v.(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["nested_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.NestedType = &iottwinmaker.DataType{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["unit_of_measure"].(string); ok && v != "" {
+			apiObject.NestedType.UnitOfMeasure = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["relationship"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Relationship = &iottwinmaker.Relationship{}
+
+		if v, ok := tfMap["relationship_type"].(string); ok && v != "" {
+			apiObject.Relationship.RelationshipType = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_component_type_id"].(string); ok && v != "" {
+			apiObject.Relationship.TargetComponentTypeId = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["unit_of_measure"].(string); ok && v != "" {
+		apiObject.UnitOfMeasure = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataValue(tfMap map[string]interface{}) *iottwinmaker.DataValue {
+	apiObject := &iottwinmaker.DataValue{}
+
+	if v, ok := tfMap["boolean_value"].(bool); ok && v {
+		apiObject.BooleanValue = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+		apiObject.DoubleValue = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	if v, ok := tfMap["integer_value"].(int); ok && v != 0 {
+		apiObject.IntegerValue = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["long_value"].(int); ok && v != 0 {
+		apiObject.LongValue = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["string_value"].(string); ok && v != "" {
+		apiObject.StringValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// flattenFunctionResponses flattens the component type's own functions.
+// Functions inherited from the component types it extends are omitted.
+func flattenFunctionResponses(apiObjects map[string]*iottwinmaker.FunctionResponse) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil || aws.BoolValue(apiObject.IsInherited) {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":                name,
+			"required_properties": aws.StringValueSlice(apiObject.RequiredProperties),
+			"scope":               aws.StringValue(apiObject.Scope),
+		}
+
+		if v := apiObject.ImplementedBy; v != nil {
+			m := map[string]interface{}{
+				"is_native": aws.BoolValue(v.IsNative),
+			}
+
+			if v := v.Lambda; v != nil {
+				m["lambda_arn"] = aws.StringValue(v.Arn)
+			}
+
+			tfMap["implemented_by"] = []interface{}{m}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenPropertyDefinitionResponses flattens the component type's own property definitions.
+// Property definitions inherited from the component types it extends are omitted.
+func flattenPropertyDefinitionResponses(apiObjects map[string]*iottwinmaker.PropertyDefinitionResponse) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil || aws.BoolValue(apiObject.IsInherited) {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"configuration":         aws.StringValueMap(apiObject.Configuration),
+			"is_external_id":        aws.BoolValue(apiObject.IsExternalId),
+			"is_required_in_entity": aws.BoolValue(apiObject.IsRequiredInEntity),
+			"is_stored_externally":  aws.BoolValue(apiObject.IsStoredExternally),
+			"is_time_series":        aws.BoolValue(apiObject.IsTimeSeries),
+			"name":                  name,
+		}
+
+		if v := apiObject.DataType; v != nil {
+			tfMap["data_type"] = []interface{}{flattenDataType(v)}
+		}
+
+		if v := apiObject.DefaultValue; v != nil {
+			tfMap["default_value"] = []interface{}{map[string]interface{}{
+				"boolean_value": aws.BoolValue(v.BooleanValue),
+				"double_value":  aws.Float64Value(v.DoubleValue),
+				"expression":    aws.StringValue(v.Expression),
+				"integer_value": aws.Int64Value(v.IntegerValue),
+				"long_value":    aws.Int64Value(v.LongValue),
+				"string_value":  aws.StringValue(v.StringValue),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataType(apiObject *iottwinmaker.DataType) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"type":            aws.StringValue(apiObject.Type),
+		"unit_of_measure": aws.StringValue(apiObject.UnitOfMeasure),
+	}
+
+	if v := apiObject.AllowedValues; len(v) > 0 {
+		var allowedValues []interface{}
+
+		for _, v := range v {
+			if v == nil {
+				continue
+			}
+
+			allowedValues = append(allowedValues, aws.StringValue(v.StringValue))
+		}
+
+		tfMap["allowed_values"] = allowedValues
+	}
+
+	if v := apiObject.NestedType; v != nil {
+		tfMap["nested_type"] = []interface{}{map[string]interface{}{
+			"type":            aws.StringValue(v.Type),
+			"unit_of_measure": aws.StringValue(v.UnitOfMeasure),
+		}}
+	}
+
+	if v := apiObject.Relationship; v != nil {
+		tfMap["relationship"] = []interface{}{map[string]interface{}{
+			"relationship_type":        aws.StringValue(v.RelationshipType),
+			"target_component_type_id": aws.StringValue(v.TargetComponentTypeId),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,304 @@
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTTwinMakerComponentType_basic(t *testing.T) {
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckComponentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iottwinmaker", fmt.Sprintf("workspace/%[1]s/component-type/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "component_type_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "function.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "is_abstract", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_singleton", "false"),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						"name":                  "temperature",
+						"data_type.#":           "1",
+						"data_type.0.type":      iottwinmaker.TypeDouble,
+						"is_required_in_entity": "false",
+						"is_time_series":        "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", iottwinmaker.StateActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentTypeConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						"name":                         "temperature",
+						"data_type.#":                  "1",
+						"data_type.0.type":             iottwinmaker.TypeDouble,
+						"data_type.0.unit_of_measure":  "Celsius",
+						"default_value.#":              "1",
+						"default_value.0.double_value": "20.5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						"name":                         "mode",
+						"data_type.#":                  "1",
+						"data_type.0.type":             iottwinmaker.TypeString,
+						"data_type.0.allowed_values.#": "2",
+						"is_required_in_entity":        "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_disappears(t *testing.T) {
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckComponentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiottwinmaker.ResourceComponentType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_tags(t *testing.T) {
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckComponentTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentTypeConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentTypeConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iottwinmaker_component_type" {
+			continue
+		}
+
+		workspaceID, componentTypeID, err := tfiottwinmaker.ComponentTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfiottwinmaker.FindComponentTypeByTwoPartKey(context.Background(), conn, workspaceID, componentTypeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT TwinMaker Component Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckComponentTypeExists(n string, v *iottwinmaker.GetComponentTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT TwinMaker Component Type ID is set")
+		}
+
+		workspaceID, componentTypeID, err := tfiottwinmaker.ComponentTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn
+
+		output, err := tfiottwinmaker.FindComponentTypeByTwoPartKey(context.Background(), conn, workspaceID, componentTypeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComponentTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccComponentTypeConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+  description       = "Terraform acceptance test"
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type            = "DOUBLE"
+      unit_of_measure = "Celsius"
+    }
+
+    default_value {
+      double_value = 20.5
+    }
+  }
+
+  property_definition {
+    name                  = "mode"
+    is_required_in_entity = true
+
+    data_type {
+      type           = "STRING"
+      allowed_values = ["AUTO", "MANUAL"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccComponentTypeConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccComponentTypeConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
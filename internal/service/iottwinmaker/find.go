@@ -0,0 +1,88 @@
+package iottwinmaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindComponentTypeByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string) (*iottwinmaker.GetComponentTypeOutput, error) {
+	input := &iottwinmaker.GetComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	output, err := conn.GetComponentTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSceneByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, sceneID string) (*iottwinmaker.GetSceneOutput, error) {
+	input := &iottwinmaker.GetSceneInput{
+		SceneId:     aws.String(sceneID),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetSceneWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindWorkspaceByID(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, id string) (*iottwinmaker.GetWorkspaceOutput, error) {
+	input := &iottwinmaker.GetWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.GetWorkspaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
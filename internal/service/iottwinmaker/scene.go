@@ -0,0 +1,257 @@
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceScene() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSceneCreate,
+		ReadWithoutTimeout:   resourceSceneRead,
+		UpdateWithoutTimeout: resourceSceneUpdate,
+		DeleteWithoutTimeout: resourceSceneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+			"content_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[sS]3://`), "must be an S3 URL"),
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"scene_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSceneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	workspaceID := d.Get("workspace_id").(string)
+	sceneID := d.Get("scene_id").(string)
+	id := SceneCreateResourceID(workspaceID, sceneID)
+	input := &iottwinmaker.CreateSceneInput{
+		ContentLocation: aws.String(d.Get("content_location").(string)),
+		SceneId:         aws.String(sceneID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT TwinMaker Scene: %s", input)
+	_, err := conn.CreateSceneWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT TwinMaker Scene (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSceneRead(ctx, d, meta)
+}
+
+func resourceSceneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	workspaceID, sceneID, err := SceneParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSceneByTwoPartKey(ctx, conn, workspaceID, sceneID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Scene (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("capabilities", aws.StringValueSlice(output.Capabilities))
+	d.Set("content_location", output.ContentLocation)
+	d.Set("creation_date_time", aws.TimeValue(output.CreationDateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("scene_id", output.SceneId)
+	d.Set("update_date_time", aws.TimeValue(output.UpdateDateTime).Format(time.RFC3339))
+	d.Set("workspace_id", output.WorkspaceId)
+
+	tags, err := ListTags(conn, aws.StringValue(output.Arn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSceneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		workspaceID, sceneID, err := SceneParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &iottwinmaker.UpdateSceneInput{
+			Capabilities:    flex.ExpandStringSet(d.Get("capabilities").(*schema.Set)),
+			ContentLocation: aws.String(d.Get("content_location").(string)),
+			Description:     aws.String(d.Get("description").(string)),
+			SceneId:         aws.String(sceneID),
+			WorkspaceId:     aws.String(workspaceID),
+		}
+
+		log.Printf("[DEBUG] Updating IoT TwinMaker Scene: %s", input)
+		_, err = conn.UpdateSceneWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT TwinMaker Scene (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT TwinMaker Scene (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSceneRead(ctx, d, meta)
+}
+
+func resourceSceneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+
+	workspaceID, sceneID, err := SceneParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Scene: %s", d.Id())
+	_, err = conn.DeleteSceneWithContext(ctx, &iottwinmaker.DeleteSceneInput{
+		SceneId:     aws.String(sceneID),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const sceneResourceIDSeparator = ","
+
+func SceneCreateResourceID(workspaceID, sceneID string) string {
+	parts := []string{workspaceID, sceneID}
+	id := strings.Join(parts, sceneResourceIDSeparator)
+
+	return id
+}
+
+func SceneParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, sceneResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sSCENE-ID", id, sceneResourceIDSeparator)
+}
@@ -0,0 +1,166 @@
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTTwinMakerScene_basic(t *testing.T) {
+	var v iottwinmaker.GetSceneOutput
+	resourceName := "aws_iottwinmaker_scene.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSceneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iottwinmaker", fmt.Sprintf("workspace/%[1]s/scene/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "content_location", fmt.Sprintf("s3://%s/scene.json", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "scene_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_date_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSceneConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "MATTERPORT"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerScene_disappears(t *testing.T) {
+	var v iottwinmaker.GetSceneOutput
+	resourceName := "aws_iottwinmaker_scene.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSceneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiottwinmaker.ResourceScene(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSceneDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iottwinmaker_scene" {
+			continue
+		}
+
+		workspaceID, sceneID, err := tfiottwinmaker.SceneParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfiottwinmaker.FindSceneByTwoPartKey(context.Background(), conn, workspaceID, sceneID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT TwinMaker Scene %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSceneExists(n string, v *iottwinmaker.GetSceneOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT TwinMaker Scene ID is set")
+		}
+
+		workspaceID, sceneID, err := tfiottwinmaker.SceneParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn
+
+		output, err := tfiottwinmaker.FindSceneByTwoPartKey(context.Background(), conn, workspaceID, sceneID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSceneConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_bucket.test.bucket}/scene.json"
+}
+`, rName))
+}
+
+func testAccSceneConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_bucket.test.bucket}/scene.json"
+  description      = "Terraform acceptance test"
+  capabilities     = ["MATTERPORT"]
+}
+`, rName))
+}
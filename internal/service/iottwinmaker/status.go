@@ -0,0 +1,26 @@
+package iottwinmaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusComponentType(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
@@ -0,0 +1,216 @@
+//go:build sweep
+// +build sweep
+
+package iottwinmaker
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iottwinmaker_component_type", &resource.Sweeper{
+		Name: "aws_iottwinmaker_component_type",
+		F:    sweepComponentTypes,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_scene", &resource.Sweeper{
+		Name: "aws_iottwinmaker_scene",
+		F:    sweepScenes,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_workspace", &resource.Sweeper{
+		Name: "aws_iottwinmaker_workspace",
+		F:    sweepWorkspaces,
+		Dependencies: []string{
+			"aws_iottwinmaker_component_type",
+			"aws_iottwinmaker_scene",
+		},
+	})
+}
+
+func sweepComponentTypes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListWorkspacesPages(&iottwinmaker.ListWorkspacesInput{}, func(page *iottwinmaker.ListWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkspaceSummaries {
+			workspaceID := aws.StringValue(v.WorkspaceId)
+			input := &iottwinmaker.ListComponentTypesInput{
+				Filters: []*iottwinmaker.ListComponentTypesFilter{{
+					IsAbstract: aws.Bool(false),
+				}},
+				WorkspaceId: aws.String(workspaceID),
+			}
+
+			err := conn.ListComponentTypesPages(input, func(page *iottwinmaker.ListComponentTypesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ComponentTypeSummaries {
+					componentTypeID := aws.StringValue(v.ComponentTypeId)
+
+					// Built-in component types can't be deleted.
+					if strings.HasPrefix(componentTypeID, "com.amazon.") {
+						continue
+					}
+
+					r := ResourceComponentType()
+					d := r.Data(nil)
+					d.SetId(ComponentTypeCreateResourceID(workspaceID, componentTypeID))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Workspace (%s) Component Types (%s): %w", workspaceID, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT TwinMaker Component Types (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Component Type sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepScenes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListWorkspacesPages(&iottwinmaker.ListWorkspacesInput{}, func(page *iottwinmaker.ListWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkspaceSummaries {
+			workspaceID := aws.StringValue(v.WorkspaceId)
+			input := &iottwinmaker.ListScenesInput{
+				WorkspaceId: aws.String(workspaceID),
+			}
+
+			err := conn.ListScenesPages(input, func(page *iottwinmaker.ListScenesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.SceneSummaries {
+					r := ResourceScene()
+					d := r.Data(nil)
+					d.SetId(SceneCreateResourceID(workspaceID, aws.StringValue(v.SceneId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Workspace (%s) Scenes (%s): %w", workspaceID, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT TwinMaker Scenes (%s): %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Scene sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepWorkspaces(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListWorkspacesPages(&iottwinmaker.ListWorkspacesInput{}, func(page *iottwinmaker.ListWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkspaceSummaries {
+			r := ResourceWorkspace()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.WorkspaceId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitComponentTypeCreated(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateCreating},
+		Target:  []string{iottwinmaker.StateActive},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComponentTypeUpdated(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateUpdating},
+		Target:  []string{iottwinmaker.StateActive},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComponentTypeDeleted(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateDeleting},
+		Target:  []string{},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if v := output.Status.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,201 @@
+package iottwinmaker
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceCreate,
+		ReadWithoutTimeout:   resourceWorkspaceRead,
+		UpdateWithoutTimeout: resourceWorkspaceUpdate,
+		DeleteWithoutTimeout: resourceWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9][a-zA-Z_\-0-9]*[a-zA-Z0-9]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	id := d.Get("workspace_id").(string)
+	input := &iottwinmaker.CreateWorkspaceInput{
+		Role:        aws.String(d.Get("role").(string)),
+		S3Location:  aws.String(d.Get("s3_location").(string)),
+		WorkspaceId: aws.String(id),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT TwinMaker Workspace: %s", input)
+	_, err := conn.CreateWorkspaceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating IoT TwinMaker Workspace (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindWorkspaceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading IoT TwinMaker Workspace (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("creation_date_time", aws.TimeValue(output.CreationDateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("role", output.Role)
+	d.Set("s3_location", output.S3Location)
+	d.Set("update_date_time", aws.TimeValue(output.UpdateDateTime).Format(time.RFC3339))
+	d.Set("workspace_id", output.WorkspaceId)
+
+	tags, err := ListTags(conn, aws.StringValue(output.Arn))
+
+	if err != nil {
+		return diag.Errorf("listing tags for IoT TwinMaker Workspace (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+
+	if d.HasChanges("description", "role") {
+		input := &iottwinmaker.UpdateWorkspaceInput{
+			Description: aws.String(d.Get("description").(string)),
+			Role:        aws.String(d.Get("role").(string)),
+			WorkspaceId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT TwinMaker Workspace: %s", input)
+		_, err := conn.UpdateWorkspaceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating IoT TwinMaker Workspace (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating IoT TwinMaker Workspace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Workspace: %s", d.Id())
+	_, err := conn.DeleteWorkspaceWithContext(ctx, &iottwinmaker.DeleteWorkspaceInput{
+		WorkspaceId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting IoT TwinMaker Workspace (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
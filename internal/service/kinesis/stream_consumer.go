@@ -25,6 +25,11 @@ func ResourceStreamConsumer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -67,7 +72,7 @@ func resourceStreamConsumerCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(aws.StringValue(output.Consumer.ConsumerARN))
 
-	if _, err := waitStreamConsumerCreated(conn, d.Id()); err != nil {
+	if _, err := waitStreamConsumerCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for Kinesis Stream Consumer (%s) create: %w", d.Id(), err)
 	}
 
@@ -113,7 +118,7 @@ func resourceStreamConsumerDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error deleting Kinesis Stream Consumer (%s): %w", d.Id(), err)
 	}
 
-	if _, err := waitStreamConsumerDeleted(conn, d.Id()); err != nil {
+	if _, err := waitStreamConsumerDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for Kinesis Stream Consumer (%s) delete: %w", d.Id(), err)
 	}
 
@@ -161,17 +166,12 @@ func statusStreamConsumer(conn *kinesis.Kinesis, arn string) resource.StateRefre
 	}
 }
 
-const (
-	streamConsumerCreatedTimeout = 5 * time.Minute
-	streamConsumerDeletedTimeout = 5 * time.Minute
-)
-
-func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string, timeout time.Duration) (*kinesis.ConsumerDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kinesis.ConsumerStatusCreating},
 		Target:  []string{kinesis.ConsumerStatusActive},
 		Refresh: statusStreamConsumer(conn, arn),
-		Timeout: streamConsumerCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -183,12 +183,12 @@ func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string) (*kinesis.Cons
 	return nil, err
 }
 
-func waitStreamConsumerDeleted(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+func waitStreamConsumerDeleted(conn *kinesis.Kinesis, arn string, timeout time.Duration) (*kinesis.ConsumerDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kinesis.ConsumerStatusDeleting},
 		Target:  []string{},
 		Refresh: statusStreamConsumer(conn, arn),
-		Timeout: streamConsumerDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -24,6 +24,10 @@ func DataSourceStream() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"consumer_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"creation_timestamp": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -114,6 +118,7 @@ func dataSourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("closed_shards", aws.StringValueSlice(closedShards))
+	d.Set("consumer_count", stream.ConsumerCount)
 	d.Set("creation_timestamp", aws.TimeValue(stream.StreamCreationTimestamp).Unix())
 	d.Set("name", stream.StreamName)
 
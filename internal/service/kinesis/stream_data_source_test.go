@@ -26,6 +26,7 @@ func TestAccKinesisStreamDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "creation_timestamp"),
 					resource.TestCheckResourceAttr(dataSourceName, "closed_shards.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumer_count", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "name", rName),
 					resource.TestCheckResourceAttr(dataSourceName, "open_shards.#", "2"),
 					resource.TestCheckResourceAttr(dataSourceName, "retention_period", "72"),
@@ -32,6 +32,7 @@ func TestAccKinesisStream_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckStreamExists(resourceName, &stream),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "kinesis", fmt.Sprintf("stream/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "consumer_count", "0"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_type", "NONE"),
 					resource.TestCheckResourceAttr(resourceName, "enforce_consumer_deletion", "false"),
 					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
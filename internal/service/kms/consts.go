@@ -1,6 +1,10 @@
 package kms
 
-import "time"
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+)
 
 const (
 	AliasNamePrefix = "alias/"
@@ -13,3 +17,22 @@ const (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// Values not yet present in the SDK's enums.
+const (
+	customerMasterKeySpecSM2         = "SM2"
+	grantOperationDeriveSharedSecret = "DeriveSharedSecret"
+	keyUsageTypeKeyAgreement         = "KEY_AGREEMENT"
+)
+
+func customerMasterKeySpec_Values() []string {
+	return append(kms.CustomerMasterKeySpec_Values(), customerMasterKeySpecSM2)
+}
+
+func grantOperation_Values() []string {
+	return append(kms.GrantOperation_Values(), grantOperationDeriveSharedSecret)
+}
+
+func keyUsageType_Values() []string {
+	return append(kms.KeyUsageType_Values(), keyUsageTypeKeyAgreement)
+}
@@ -65,7 +65,7 @@ func ResourceGrant() *schema.Resource {
 				Set:  schema.HashString,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(kms.GrantOperation_Values(), false),
+					ValidateFunc: validation.StringInSlice(grantOperation_Values(), false),
 				},
 				Required: true,
 				ForceNew: true,
@@ -1,12 +1,14 @@
 package kms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -28,7 +30,10 @@ func ResourceKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceKeyCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -45,7 +50,7 @@ func ResourceKey() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      kms.CustomerMasterKeySpecSymmetricDefault,
-				ValidateFunc: validation.StringInSlice(kms.CustomerMasterKeySpec_Values(), false),
+				ValidateFunc: validation.StringInSlice(customerMasterKeySpec_Values(), false),
 			},
 			"deletion_window_in_days": {
 				Type:         schema.TypeInt,
@@ -77,7 +82,7 @@ func ResourceKey() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      kms.KeyUsageTypeEncryptDecrypt,
-				ValidateFunc: validation.StringInSlice(kms.KeyUsageType_Values(), false),
+				ValidateFunc: validation.StringInSlice(keyUsageType_Values(), false),
 			},
 			"multi_region": {
 				Type:     schema.TypeBool,
@@ -493,3 +498,11 @@ func updateKeyRotationEnabled(conn *kms.KMS, keyID string, enabled bool) error {
 
 	return nil
 }
+
+func resourceKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("key_usage") || !diff.NewValueKnown("customer_master_key_spec") {
+		return nil
+	}
+
+	return validKeyUsageForKeySpec(diff.Get("key_usage").(string), diff.Get("customer_master_key_spec").(string))
+}
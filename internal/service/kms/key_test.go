@@ -121,6 +121,46 @@ func TestAccKMSKey_asymmetricKey(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_keyAgreement(t *testing.T) {
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_keyUsageAndSpec(rName, "KEY_AGREEMENT", "ECC_NIST_P256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "customer_master_key_spec", "ECC_NIST_P256"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "KEY_AGREEMENT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_invalidKeyUsageForKeySpec(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyConfig_keyUsageAndSpec(rName, "KEY_AGREEMENT", "ECC_SECG_P256K1"),
+				ExpectError: regexp.MustCompile(`key_usage KEY_AGREEMENT is not supported with customer_master_key_spec ECC_SECG_P256K1`),
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_Policy_basic(t *testing.T) {
 	var key kms.KeyMetadata
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -580,6 +620,18 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_keyUsageAndSpec(rName, keyUsage, keySpec string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  key_usage                = %[2]q
+  customer_master_key_spec = %[3]q
+}
+`, rName, keyUsage, keySpec)
+}
+
 func testAccKeyConfig_policy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -15,6 +15,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceReplicaKey() *schema.Resource {
@@ -125,13 +126,13 @@ func resourceReplicaKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Replication is initiated in the primary key's region.
-	session, err := conns.NewSessionForRegion(&conn.Config, primaryKeyARN.Region, meta.(*conns.AWSClient).TerraformVersion)
+	v, err := meta.(*conns.AWSClient).RegionalConn(names.KMS, primaryKeyARN.Region)
 
 	if err != nil {
-		return fmt.Errorf("error creating AWS session: %w", err)
+		return fmt.Errorf("error creating KMS client for Region (%s): %w", primaryKeyARN.Region, err)
 	}
 
-	replicateConn := kms.New(session)
+	replicateConn := v.(*kms.KMS)
 
 	log.Printf("[DEBUG] Creating KMS Replica Key: %s", input)
 	outputRaw, err := WaitIAMPropagation(func() (interface{}, error) {
@@ -3,6 +3,8 @@ package kms
 import (
 	"fmt"
 	"regexp"
+
+	"github.com/aws/aws-sdk-go/service/kms"
 )
 
 func validGrantName(v interface{}, k string) (ws []string, es []error) {
@@ -58,3 +60,36 @@ func validKey(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// keyUsagesByKeySpec lists the key usages permitted for each key spec.
+var keyUsagesByKeySpec = map[string][]string{
+	kms.CustomerMasterKeySpecSymmetricDefault: {kms.KeyUsageTypeEncryptDecrypt},
+	kms.CustomerMasterKeySpecRsa2048:          {kms.KeyUsageTypeEncryptDecrypt, kms.KeyUsageTypeSignVerify},
+	kms.CustomerMasterKeySpecRsa3072:          {kms.KeyUsageTypeEncryptDecrypt, kms.KeyUsageTypeSignVerify},
+	kms.CustomerMasterKeySpecRsa4096:          {kms.KeyUsageTypeEncryptDecrypt, kms.KeyUsageTypeSignVerify},
+	kms.CustomerMasterKeySpecEccNistP256:      {kms.KeyUsageTypeSignVerify, keyUsageTypeKeyAgreement},
+	kms.CustomerMasterKeySpecEccNistP384:      {kms.KeyUsageTypeSignVerify, keyUsageTypeKeyAgreement},
+	kms.CustomerMasterKeySpecEccNistP521:      {kms.KeyUsageTypeSignVerify, keyUsageTypeKeyAgreement},
+	kms.CustomerMasterKeySpecEccSecgP256k1:    {kms.KeyUsageTypeSignVerify},
+	kms.CustomerMasterKeySpecHmac224:          {kms.KeyUsageTypeGenerateVerifyMac},
+	kms.CustomerMasterKeySpecHmac256:          {kms.KeyUsageTypeGenerateVerifyMac},
+	kms.CustomerMasterKeySpecHmac384:          {kms.KeyUsageTypeGenerateVerifyMac},
+	kms.CustomerMasterKeySpecHmac512:          {kms.KeyUsageTypeGenerateVerifyMac},
+	customerMasterKeySpecSM2:                  {kms.KeyUsageTypeEncryptDecrypt, kms.KeyUsageTypeSignVerify, keyUsageTypeKeyAgreement},
+}
+
+func validKeyUsageForKeySpec(keyUsage, keySpec string) error {
+	keyUsages, ok := keyUsagesByKeySpec[keySpec]
+
+	if !ok {
+		return nil
+	}
+
+	for _, v := range keyUsages {
+		if v == keyUsage {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("key_usage %s is not supported with customer_master_key_spec %s, expected one of %v", keyUsage, keySpec, keyUsages)
+}
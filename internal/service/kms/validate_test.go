@@ -159,3 +159,39 @@ func TestValidKey(t *testing.T) {
 		}
 	}
 }
+
+func TestValidKeyUsageForKeySpec(t *testing.T) {
+	testCases := []struct {
+		keyUsage string
+		keySpec  string
+		valid    bool
+	}{
+		{"ENCRYPT_DECRYPT", "SYMMETRIC_DEFAULT", true},
+		{"SIGN_VERIFY", "SYMMETRIC_DEFAULT", false},
+		{"ENCRYPT_DECRYPT", "RSA_2048", true},
+		{"SIGN_VERIFY", "RSA_4096", true},
+		{"KEY_AGREEMENT", "RSA_3072", false},
+		{"SIGN_VERIFY", "ECC_NIST_P256", true},
+		{"KEY_AGREEMENT", "ECC_NIST_P384", true},
+		{"ENCRYPT_DECRYPT", "ECC_NIST_P521", false},
+		{"KEY_AGREEMENT", "ECC_SECG_P256K1", false},
+		{"GENERATE_VERIFY_MAC", "HMAC_256", true},
+		{"ENCRYPT_DECRYPT", "HMAC_512", false},
+		{"ENCRYPT_DECRYPT", "SM2", true},
+		{"SIGN_VERIFY", "SM2", true},
+		{"KEY_AGREEMENT", "SM2", true},
+		{"GENERATE_VERIFY_MAC", "SM2", false},
+	}
+
+	for _, testCase := range testCases {
+		err := validKeyUsageForKeySpec(testCase.keyUsage, testCase.keySpec)
+
+		if testCase.valid && err != nil {
+			t.Errorf("key_usage %s with key spec %s: unexpected error: %s", testCase.keyUsage, testCase.keySpec, err)
+		}
+
+		if !testCase.valid && err == nil {
+			t.Errorf("key_usage %s with key spec %s: expected error", testCase.keyUsage, testCase.keySpec)
+		}
+	}
+}
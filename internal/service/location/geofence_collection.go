@@ -0,0 +1,194 @@
+package location
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGeofenceCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGeofenceCollectionCreate,
+		Read:   resourceGeofenceCollectionRead,
+		Update: resourceGeofenceCollectionUpdate,
+		Delete: resourceGeofenceCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"collection_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGeofenceCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &locationservice.CreateGeofenceCollectionInput{
+		CollectionName: aws.String(d.Get("collection_name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateGeofenceCollection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Location Service Geofence Collection: %w", err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating Location Service Geofence Collection: empty result")
+	}
+
+	d.SetId(aws.StringValue(output.CollectionName))
+
+	return resourceGeofenceCollectionRead(d, meta)
+}
+
+func resourceGeofenceCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &locationservice.DescribeGeofenceCollectionInput{
+		CollectionName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeGeofenceCollection(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Location Service Geofence Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Location Service Geofence Collection (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error getting Location Service Geofence Collection (%s): empty response", d.Id())
+	}
+
+	d.Set("collection_arn", output.CollectionArn)
+	d.Set("collection_name", output.CollectionName)
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("kms_key_id", output.KmsKeyId)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceGeofenceCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChange("description") {
+		input := &locationservice.UpdateGeofenceCollectionInput{
+			CollectionName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateGeofenceCollection(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Location Service Geofence Collection (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("collection_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags for Location Service Geofence Collection (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceGeofenceCollectionRead(d, meta)
+}
+
+func resourceGeofenceCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	input := &locationservice.DeleteGeofenceCollectionInput{
+		CollectionName: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteGeofenceCollection(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Location Service Geofence Collection (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
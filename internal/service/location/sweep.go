@@ -16,6 +16,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
+		Name: "aws_location_geofence_collection",
+		F:    sweepGeofenceCollections,
+	})
+
 	resource.AddTestSweepers("aws_location_map", &resource.Sweeper{
 		Name: "aws_location_map",
 		F:    sweepMaps,
@@ -27,6 +32,53 @@ func init() {
 	})
 }
 
+func sweepGeofenceCollections(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).LocationConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &locationservice.ListGeofenceCollectionsInput{}
+
+	err = conn.ListGeofenceCollectionsPages(input, func(page *locationservice.ListGeofenceCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, entry := range page.Entries {
+			r := ResourceGeofenceCollection()
+			d := r.Data(nil)
+
+			id := aws.StringValue(entry.CollectionName)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Location Service Geofence Collection for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Location Service Geofence Collection for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Location Service Geofence Collection sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepMaps(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 
@@ -0,0 +1,323 @@
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceCoreNetworkPolicy() *schema.Resource {
+	changeValuesSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edge_locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"segment_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_segments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoreNetworkPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringInSlice(networkmanager.CoreNetworkPolicyAlias_Values(), false),
+				ConflictsWith: []string{"policy_version_id"},
+			},
+			"change_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"new_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     changeValuesSchema,
+						},
+						"previous_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     changeValuesSchema,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"change_set_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_version_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"alias"},
+			},
+		},
+	}
+}
+
+func dataSourceCoreNetworkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	coreNetworkID := d.Get("core_network_id").(string)
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	if v, ok := d.GetOk("alias"); ok {
+		input.Alias = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_version_id"); ok {
+		input.PolicyVersionId = aws.Int64(int64(v.(int)))
+	}
+
+	policy, err := FindCoreNetworkPolicy(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s) policy: %s", coreNetworkID, err)
+	}
+
+	policyVersionID := aws.Int64Value(policy.PolicyVersionId)
+	changes, err := FindCoreNetworkChangeSetByTwoPartKey(ctx, conn, coreNetworkID, policyVersionID)
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s) policy version (%d) change set: %s", coreNetworkID, policyVersionID, err)
+	}
+
+	d.SetId(coreNetworkID)
+	d.Set("alias", policy.Alias)
+	if err := d.Set("change_set", flattenCoreNetworkChanges(changes)); err != nil {
+		return diag.Errorf("error setting change_set: %s", err)
+	}
+	d.Set("change_set_state", policy.ChangeSetState)
+	d.Set("core_network_id", policy.CoreNetworkId)
+	if policy.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(policy.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("description", policy.Description)
+	if policy.PolicyDocument != nil {
+		v, err := protocol.EncodeJSONValue(policy.PolicyDocument, protocol.NoEscape)
+
+		if err != nil {
+			return diag.Errorf("error encoding Network Manager Core Network (%s) policy document: %s", coreNetworkID, err)
+		}
+
+		d.Set("policy_document", v)
+	} else {
+		d.Set("policy_document", nil)
+	}
+	if err := d.Set("policy_errors", flattenCoreNetworkPolicyErrors(policy.PolicyErrors)); err != nil {
+		return diag.Errorf("error setting policy_errors: %s", err)
+	}
+	d.Set("policy_version_id", policyVersionID)
+
+	return nil
+}
+
+func FindCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
+	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetworkPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetworkPolicy, nil
+}
+
+func FindCoreNetworkChangeSetByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) ([]*networkmanager.CoreNetworkChange, error) {
+	input := &networkmanager.GetCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+	var output []*networkmanager.CoreNetworkChange
+
+	err := conn.GetCoreNetworkChangeSetPagesWithContext(ctx, input, func(page *networkmanager.GetCoreNetworkChangeSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CoreNetworkChanges {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenCoreNetworkChanges(apiObjects []*networkmanager.CoreNetworkChange) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"action":          aws.StringValue(apiObject.Action),
+			"identifier":      aws.StringValue(apiObject.Identifier),
+			"new_values":      flattenCoreNetworkChangeValues(apiObject.NewValues),
+			"previous_values": flattenCoreNetworkChangeValues(apiObject.PreviousValues),
+			"type":            aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCoreNetworkChangeValues(apiObject *networkmanager.CoreNetworkChangeValues) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"asn":                    aws.Int64Value(apiObject.Asn),
+		"cidr":                   aws.StringValue(apiObject.Cidr),
+		"destination_identifier": aws.StringValue(apiObject.DestinationIdentifier),
+		"edge_locations":         flex.FlattenStringList(apiObject.EdgeLocations),
+		"inside_cidr_blocks":     flex.FlattenStringList(apiObject.InsideCidrBlocks),
+		"segment_name":           aws.StringValue(apiObject.SegmentName),
+		"shared_segments":        flex.FlattenStringList(apiObject.SharedSegments),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCoreNetworkPolicyErrors(apiObjects []*networkmanager.CoreNetworkPolicyError) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"error_code": aws.StringValue(apiObject.ErrorCode),
+			"message":    aws.StringValue(apiObject.Message),
+			"path":       aws.StringValue(apiObject.Path),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,60 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// The provider does not yet manage core networks, so these tests require an existing core network.
+func testAccCoreNetworkPolicyPreCheck(t *testing.T) string {
+	coreNetworkID := os.Getenv("NETWORKMANAGER_CORE_NETWORK_ID")
+	if coreNetworkID == "" {
+		t.Skip("Environment variable NETWORKMANAGER_CORE_NETWORK_ID is not set")
+	}
+
+	return coreNetworkID
+}
+
+func TestAccNetworkManagerCoreNetworkPolicyDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_core_network_policy.test"
+	coreNetworkID := testAccCoreNetworkPolicyPreCheck(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyDataSourceConfig_alias(coreNetworkID, networkmanager.CoreNetworkPolicyAliasLive),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alias", networkmanager.CoreNetworkPolicyAliasLive),
+					resource.TestCheckResourceAttr(dataSourceName, "core_network_id", coreNetworkID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_document"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_version_id"),
+				),
+			},
+			{
+				Config: testAccCoreNetworkPolicyDataSourceConfig_alias(coreNetworkID, networkmanager.CoreNetworkPolicyAliasLatest),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alias", networkmanager.CoreNetworkPolicyAliasLatest),
+					resource.TestCheckResourceAttrSet(dataSourceName, "change_set_state"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_document"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoreNetworkPolicyDataSourceConfig_alias(coreNetworkID, alias string) string {
+	return fmt.Sprintf(`
+data "aws_networkmanager_core_network_policy" "test" {
+  core_network_id = %[1]q
+  alias           = %[2]q
+}
+`, coreNetworkID, alias)
+}
@@ -16,6 +16,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const (
@@ -421,13 +422,13 @@ func connForRegion(region string, meta interface{}) (*opsworks.OpsWorks, error)
 		return originalConn, nil
 	}
 
-	sess, err := conns.NewSessionForRegion(&originalConn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+	v, err := meta.(*conns.AWSClient).RegionalConn(names.OpsWorks, region)
 
 	if err != nil {
-		return nil, fmt.Errorf("error creating AWS session: %w", err)
+		return nil, fmt.Errorf("error creating OpsWorks client for Region (%s): %w", region, err)
 	}
 
-	return opsworks.New(sess), nil
+	return v.(*opsworks.OpsWorks), nil
 }
 
 func resourceStackCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1,6 +1,7 @@
 package organizations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,6 +31,10 @@ func ResourceAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -39,6 +45,10 @@ func ResourceAccount() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"close_on_deletion_confirmation": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"create_govcloud": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -97,7 +107,10 @@ func ResourceAccount() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAccountCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -247,38 +260,103 @@ func resourceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
-	close := d.Get("close_on_deletion").(bool)
-	var err error
+	if d.Get("close_on_deletion").(bool) {
+		return closeAccount(conn, d)
+	}
+
+	log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
+	_, err := conn.RemoveAccountFromOrganization(&organizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AWS Organizations Account (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func closeAccount(conn *organizations.Organizations, d *schema.ResourceData) error {
+	if err := checkCloseOnDeletionConfirmation(d.Get("name").(string), d.Get("close_on_deletion_confirmation").(string)); err != nil {
+		return fmt.Errorf("error closing AWS Organizations Account (%s): %w", d.Id(), err)
+	}
+
+	account, err := FindAccountByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AWS Organizations Account (%s): %w", d.Id(), err)
+	}
 
-	if close {
+	// The account may already be pending closure, e.g. after a previous delete timed out.
+	// FindAccountByID treats SUSPENDED (closed) accounts as not found.
+	if status := aws.StringValue(account.Status); status != organizations.AccountStatusActive {
+		log.Printf("[DEBUG] AWS Organizations Account (%s) is already %s", d.Id(), status)
+	} else {
 		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
 		_, err = conn.CloseAccount(&organizations.CloseAccountInput{
 			AccountId: aws.String(d.Id()),
 		})
-	} else {
-		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
-		_, err = conn.RemoveAccountFromOrganization(&organizations.RemoveAccountFromOrganizationInput{
-			AccountId: aws.String(d.Id()),
-		})
+
+		if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+			return nil
+		}
+
+		if reason := constraintViolationReason(err); reason == organizations.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded || reason == organizations.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded {
+			return fmt.Errorf("error closing AWS Organizations Account (%s): account closure quota exhausted (%s), close the account manually or remove it from state with 'terraform state rm': %w", d.Id(), reason, err)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error closing AWS Organizations Account (%s): %w", d.Id(), err)
+		}
 	}
 
-	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+	if _, err := waitAccountDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for AWS Organizations Account (%s) close: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAccountCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("close_on_deletion").(bool) || !diff.NewValueKnown("name") || !diff.NewValueKnown("close_on_deletion_confirmation") {
 		return nil
 	}
 
-	if err != nil {
-		return fmt.Errorf("error deleting AWS Organizations Account (%s): %w", d.Id(), err)
+	return checkCloseOnDeletionConfirmation(diff.Get("name").(string), diff.Get("close_on_deletion_confirmation").(string))
+}
+
+// checkCloseOnDeletionConfirmation returns an error unless close_on_deletion_confirmation
+// matches the account's name.
+func checkCloseOnDeletionConfirmation(name, confirmation string) error {
+	if confirmation == "" {
+		return fmt.Errorf("close_on_deletion_confirmation must be set to the account name (%s) when close_on_deletion is true", name)
 	}
 
-	if close {
-		if _, err := waitAccountDeleted(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for AWS Organizations Account (%s) delete: %w", d.Id(), err)
-		}
+	if confirmation != name {
+		return fmt.Errorf("close_on_deletion_confirmation (%s) does not match the account name (%s); refusing to close the account", confirmation, name)
 	}
 
 	return nil
 }
 
+func constraintViolationReason(err error) string {
+	var constraintViolationException *organizations.ConstraintViolationException
+
+	if errors.As(err, &constraintViolationException) {
+		return aws.StringValue(constraintViolationException.Reason)
+	}
+
+	return ""
+}
+
 func createAccount(conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
 	if govCloud {
 		input := &organizations.CreateGovCloudAccountInput{
@@ -453,13 +531,13 @@ func statusAccountStatus(conn *organizations.Organizations, id string) resource.
 	}
 }
 
-func waitAccountDeleted(conn *organizations.Organizations, id string) (*organizations.Account, error) {
+func waitAccountDeleted(conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending:      []string{organizations.AccountStatusPendingClosure},
+		Pending:      []string{organizations.AccountStatusActive, organizations.AccountStatusPendingClosure},
 		Target:       []string{},
 		Refresh:      statusAccountStatus(conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -3,6 +3,7 @@ package organizations_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -22,6 +23,7 @@ func testAccAccountImportStep(n string) resource.TestStep {
 		ImportStateVerify: true,
 		ImportStateVerifyIgnore: []string{
 			"close_on_deletion",
+			"close_on_deletion_confirmation",
 			"create_govcloud",
 			"govcloud_id",
 		},
@@ -105,6 +107,56 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 	})
 }
 
+func testAccAccount_CloseOnDeletionConfirmation(t *testing.T) {
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsEnabled(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountConfig_closeOnDeletionConfirmation(name, email, "not-"+name),
+				ExpectError: regexp.MustCompile(`does not match the account name`),
+			},
+		},
+	})
+}
+
+func testAccAccount_CloseOnDeletionConfirmationMissing(t *testing.T) {
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsEnabled(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountConfig_closeOnDeletionNoConfirmation(name, email),
+				ExpectError: regexp.MustCompile(`close_on_deletion_confirmation must be set`),
+			},
+		},
+	})
+}
+
 func testAccAccount_ParentID(t *testing.T) {
 	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
 	orgsEmailDomain := os.Getenv(key)
@@ -285,6 +337,10 @@ resource "aws_organizations_account" "test" {
 }
 
 func testAccAccountConfig_closeOnDeletion(name, email string) string {
+	return testAccAccountConfig_closeOnDeletionConfirmation(name, email, name)
+}
+
+func testAccAccountConfig_closeOnDeletionNoConfirmation(name, email string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_account" "test" {
   name              = %[1]q
@@ -294,6 +350,17 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_closeOnDeletionConfirmation(name, email, confirmation string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name                           = %[1]q
+  email                          = %[2]q
+  close_on_deletion              = true
+  close_on_deletion_confirmation = %[3]q
+}
+`, name, email, confirmation)
+}
+
 func testAccAccountConfig_parentId1(name, email string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {}
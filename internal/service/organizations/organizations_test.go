@@ -30,11 +30,13 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"DataSource":                 testAccOrganizationDataSource_basic,
 		},
 		"Account": {
-			"basic":           testAccAccount_basic,
-			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
-			"ParentId":        testAccAccount_ParentID,
-			"Tags":            testAccAccount_Tags,
-			"GovCloud":        testAccAccount_govCloud,
+			"basic":                              testAccAccount_basic,
+			"CloseOnDeletion":                    testAccAccount_CloseOnDeletion,
+			"CloseOnDeletionConfirmation":        testAccAccount_CloseOnDeletionConfirmation,
+			"CloseOnDeletionConfirmationMissing": testAccAccount_CloseOnDeletionConfirmationMissing,
+			"ParentId":                           testAccAccount_ParentID,
+			"Tags":                               testAccAccount_Tags,
+			"GovCloud":                           testAccAccount_govCloud,
 		},
 		"OrganizationalUnit": {
 			"basic":      testAccOrganizationalUnit_basic,
@@ -0,0 +1,238 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConfigurationSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationSetCreate,
+		ReadWithoutTimeout:   resourceConfigurationSetRead,
+		UpdateWithoutTimeout: resourceConfigurationSetUpdate,
+		DeleteWithoutTimeout: resourceConfigurationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_message_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.MessageType_Values(), false),
+			},
+			"default_sender_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 11),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceConfigurationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &pinpointsmsvoicev2.CreateConfigurationSetInput{
+		ConfigurationSetName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint SMS and Voice V2 Configuration Set: %s", input)
+	_, err := conn.CreateConfigurationSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Pinpoint SMS and Voice V2 Configuration Set (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// The defaults can only be set once the configuration set exists.
+	if v, ok := d.GetOk("default_message_type"); ok {
+		if err := setConfigurationSetDefaultMessageType(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("default_sender_id"); ok {
+		if err := setConfigurationSetDefaultSenderID(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceConfigurationSetRead(ctx, d, meta)
+}
+
+func resourceConfigurationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	configurationSet, err := FindConfigurationSetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint SMS and Voice V2 Configuration Set %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Pinpoint SMS and Voice V2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", configurationSet.ConfigurationSetArn)
+	d.Set("default_message_type", configurationSet.DefaultMessageType)
+	d.Set("default_sender_id", configurationSet.DefaultSenderId)
+	d.Set("name", configurationSet.ConfigurationSetName)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Pinpoint SMS and Voice V2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceConfigurationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	if d.HasChange("default_message_type") {
+		if v, ok := d.GetOk("default_message_type"); ok {
+			if err := setConfigurationSetDefaultMessageType(ctx, conn, d.Id(), v.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("[DEBUG] Deleting Pinpoint SMS and Voice V2 Configuration Set (%s) default message type", d.Id())
+			_, err := conn.DeleteDefaultMessageTypeWithContext(ctx, &pinpointsmsvoicev2.DeleteDefaultMessageTypeInput{
+				ConfigurationSetName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("deleting Pinpoint SMS and Voice V2 Configuration Set (%s) default message type: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("default_sender_id") {
+		if v, ok := d.GetOk("default_sender_id"); ok {
+			if err := setConfigurationSetDefaultSenderID(ctx, conn, d.Id(), v.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			log.Printf("[DEBUG] Deleting Pinpoint SMS and Voice V2 Configuration Set (%s) default sender ID", d.Id())
+			_, err := conn.DeleteDefaultSenderIdWithContext(ctx, &pinpointsmsvoicev2.DeleteDefaultSenderIdInput{
+				ConfigurationSetName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("deleting Pinpoint SMS and Voice V2 Configuration Set (%s) default sender ID: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating tags: %s", err)
+		}
+	}
+
+	return resourceConfigurationSetRead(ctx, d, meta)
+}
+
+func resourceConfigurationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	log.Printf("[INFO] Deleting Pinpoint SMS and Voice V2 Configuration Set: %s", d.Id())
+	_, err := conn.DeleteConfigurationSetWithContext(ctx, &pinpointsmsvoicev2.DeleteConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Pinpoint SMS and Voice V2 Configuration Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setConfigurationSetDefaultMessageType(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, name, messageType string) error {
+	input := &pinpointsmsvoicev2.SetDefaultMessageTypeInput{
+		ConfigurationSetName: aws.String(name),
+		MessageType:          aws.String(messageType),
+	}
+
+	log.Printf("[DEBUG] Setting Pinpoint SMS and Voice V2 Configuration Set default message type: %s", input)
+	if _, err := conn.SetDefaultMessageTypeWithContext(ctx, input); err != nil {
+		return fmt.Errorf("setting Pinpoint SMS and Voice V2 Configuration Set (%s) default message type: %w", name, err)
+	}
+
+	return nil
+}
+
+func setConfigurationSetDefaultSenderID(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, name, senderID string) error {
+	input := &pinpointsmsvoicev2.SetDefaultSenderIdInput{
+		ConfigurationSetName: aws.String(name),
+		SenderId:             aws.String(senderID),
+	}
+
+	log.Printf("[DEBUG] Setting Pinpoint SMS and Voice V2 Configuration Set default sender ID: %s", input)
+	if _, err := conn.SetDefaultSenderIdWithContext(ctx, input); err != nil {
+		return fmt.Errorf("setting Pinpoint SMS and Voice V2 Configuration Set (%s) default sender ID: %w", name, err)
+	}
+
+	return nil
+}
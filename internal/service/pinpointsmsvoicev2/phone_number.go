@@ -0,0 +1,270 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePhoneNumber() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePhoneNumberCreate,
+		ReadWithoutTimeout:   resourcePhoneNumberRead,
+		UpdateWithoutTimeout: resourcePhoneNumberUpdate,
+		DeleteWithoutTimeout: resourcePhoneNumberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"iso_country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.MessageType_Values(), false),
+			},
+			"monthly_leasing_price": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.NumberCapability_Values(), false),
+				},
+			},
+			"number_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.RequestableNumberType_Values(), false),
+			},
+			"opt_out_list_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self_managed_opt_outs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"two_way_channel_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"two_way_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &pinpointsmsvoicev2.RequestPhoneNumberInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		IsoCountryCode:            aws.String(d.Get("iso_country_code").(string)),
+		MessageType:               aws.String(d.Get("message_type").(string)),
+		NumberCapabilities:        flex.ExpandStringSet(d.Get("number_capabilities").(*schema.Set)),
+		NumberType:                aws.String(d.Get("number_type").(string)),
+	}
+
+	if v, ok := d.GetOk("opt_out_list_name"); ok {
+		input.OptOutListName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Requesting Pinpoint SMS and Voice V2 Phone Number: %s", input)
+	output, err := conn.RequestPhoneNumberWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("requesting Pinpoint SMS and Voice V2 Phone Number: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PhoneNumberId))
+
+	if _, err := waitPhoneNumberActive(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Pinpoint SMS and Voice V2 Phone Number (%s) create: %s", d.Id(), err)
+	}
+
+	// Two-way messaging and self-managed opt-outs can only be configured once the number is active.
+	if d.Get("self_managed_opt_outs_enabled").(bool) || d.Get("two_way_enabled").(bool) {
+		if err := updatePhoneNumber(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePhoneNumberRead(ctx, d, meta)
+}
+
+func resourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	phoneNumber, err := FindPhoneNumberByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint SMS and Voice V2 Phone Number %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Pinpoint SMS and Voice V2 Phone Number (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", phoneNumber.PhoneNumberArn)
+	d.Set("deletion_protection_enabled", phoneNumber.DeletionProtectionEnabled)
+	d.Set("iso_country_code", phoneNumber.IsoCountryCode)
+	d.Set("message_type", phoneNumber.MessageType)
+	d.Set("monthly_leasing_price", phoneNumber.MonthlyLeasingPrice)
+	d.Set("number_capabilities", aws.StringValueSlice(phoneNumber.NumberCapabilities))
+	d.Set("number_type", phoneNumber.NumberType)
+	d.Set("opt_out_list_name", phoneNumber.OptOutListName)
+	d.Set("phone_number", phoneNumber.PhoneNumber)
+	d.Set("self_managed_opt_outs_enabled", phoneNumber.SelfManagedOptOutsEnabled)
+	d.Set("two_way_channel_arn", phoneNumber.TwoWayChannelArn)
+	d.Set("two_way_enabled", phoneNumber.TwoWayEnabled)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Pinpoint SMS and Voice V2 Phone Number (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := updatePhoneNumber(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating tags: %s", err)
+		}
+	}
+
+	return resourcePhoneNumberRead(ctx, d, meta)
+}
+
+func resourcePhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	log.Printf("[INFO] Releasing Pinpoint SMS and Voice V2 Phone Number: %s", d.Id())
+	_, err := conn.ReleasePhoneNumberWithContext(ctx, &pinpointsmsvoicev2.ReleasePhoneNumberInput{
+		PhoneNumberId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("releasing Pinpoint SMS and Voice V2 Phone Number (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPhoneNumberDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Pinpoint SMS and Voice V2 Phone Number (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updatePhoneNumber(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, d *schema.ResourceData) error {
+	input := &pinpointsmsvoicev2.UpdatePhoneNumberInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		PhoneNumberId:             aws.String(d.Id()),
+		SelfManagedOptOutsEnabled: aws.Bool(d.Get("self_managed_opt_outs_enabled").(bool)),
+		TwoWayEnabled:             aws.Bool(d.Get("two_way_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("opt_out_list_name"); ok {
+		input.OptOutListName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("two_way_channel_arn"); ok {
+		input.TwoWayChannelArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint SMS and Voice V2 Phone Number: %s", input)
+	if _, err := conn.UpdatePhoneNumberWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating Pinpoint SMS and Voice V2 Phone Number (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitPhoneNumberActive(ctx, conn, d.Id()); err != nil {
+		return fmt.Errorf("waiting for Pinpoint SMS and Voice V2 Phone Number (%s) update: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_basic(t *testing.T) {
+	var v pinpointsmsvoicev2.PhoneNumberInformation
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pinpointsmsvoicev2.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sms-voice", regexp.MustCompile(`phone-number/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "iso_country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "message_type", "TRANSACTIONAL"),
+					resource.TestCheckResourceAttrSet(resourceName, "monthly_leasing_price"),
+					resource.TestCheckResourceAttr(resourceName, "number_capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "number_capabilities.*", "SMS"),
+					resource.TestCheckResourceAttr(resourceName, "number_type", "TOLL_FREE"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_list_name", "Default"),
+					resource.TestCheckResourceAttrSet(resourceName, "phone_number"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "two_way_channel_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "two_way_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_disappears(t *testing.T) {
+	var v pinpointsmsvoicev2.PhoneNumberInformation
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pinpointsmsvoicev2.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpointsmsvoicev2.ResourcePhoneNumber(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2PhoneNumber_update(t *testing.T) {
+	var v pinpointsmsvoicev2.PhoneNumberInformation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpointsmsvoicev2_phone_number.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pinpointsmsvoicev2.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPhoneNumberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberConfig_optOutList(rName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "opt_out_list_name", "aws_pinpointsmsvoicev2_opt_out_list.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPhoneNumberConfig_optOutList(rName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "opt_out_list_name", "aws_pinpointsmsvoicev2_opt_out_list.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPhoneNumberExists(n string, v *pinpointsmsvoicev2.PhoneNumberInformation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint SMS and Voice V2 Phone Number ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+		output, err := tfpinpointsmsvoicev2.FindPhoneNumberByID(context.TODO(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPhoneNumberDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpointsmsvoicev2_phone_number" {
+			continue
+		}
+
+		_, err := tfpinpointsmsvoicev2.FindPhoneNumberByID(context.TODO(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint SMS and Voice V2 Phone Number %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPhoneNumberConfig_basic() string {
+	return `
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_type         = "TOLL_FREE"
+  number_capabilities = ["SMS"]
+}
+`
+}
+
+func testAccPhoneNumberConfig_optOutList(rName string, deletionProtectionEnabled, selfManagedOptOutsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_opt_out_list" "test" {
+  name = %[1]q
+}
+
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_type         = "TOLL_FREE"
+  number_capabilities = ["SMS"]
+
+  deletion_protection_enabled   = %[2]t
+  opt_out_list_name             = aws_pinpointsmsvoicev2_opt_out_list.test.name
+  self_managed_opt_outs_enabled = %[3]t
+}
+`, rName, deletionProtectionEnabled, selfManagedOptOutsEnabled)
+}
@@ -0,0 +1,333 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePool() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePoolCreate,
+		ReadWithoutTimeout:   resourcePoolRead,
+		UpdateWithoutTimeout: resourcePoolUpdate,
+		DeleteWithoutTimeout: resourcePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"iso_country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(pinpointsmsvoicev2.MessageType_Values(), false),
+			},
+			"opt_out_list_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"origination_identities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"self_managed_opt_outs_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"shared_routes_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"two_way_channel_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"two_way_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	// A pool is created with a single origination identity; any others are associated afterwards.
+	isoCountryCode := d.Get("iso_country_code").(string)
+	originationIdentities := d.Get("origination_identities").(*schema.Set).List()
+	input := &pinpointsmsvoicev2.CreatePoolInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		IsoCountryCode:            aws.String(isoCountryCode),
+		MessageType:               aws.String(d.Get("message_type").(string)),
+		OriginationIdentity:       aws.String(originationIdentities[0].(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint SMS and Voice V2 Pool: %s", input)
+	output, err := conn.CreatePoolWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Pinpoint SMS and Voice V2 Pool: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.PoolId))
+
+	if _, err := waitPoolActive(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Pinpoint SMS and Voice V2 Pool (%s) create: %s", d.Id(), err)
+	}
+
+	for _, v := range originationIdentities[1:] {
+		if err := associatePoolOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, optOutListNameSet := d.GetOk("opt_out_list_name")
+	_, twoWayChannelARNSet := d.GetOk("two_way_channel_arn")
+
+	if optOutListNameSet || twoWayChannelARNSet || d.Get("self_managed_opt_outs_enabled").(bool) || d.Get("shared_routes_enabled").(bool) || d.Get("two_way_enabled").(bool) {
+		if err := updatePool(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourcePoolRead(ctx, d, meta)
+}
+
+func resourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	pool, err := FindPoolByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint SMS and Voice V2 Pool %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Pinpoint SMS and Voice V2 Pool (%s): %s", d.Id(), err)
+	}
+
+	originationIdentities, err := FindPoolOriginationIdentitiesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading Pinpoint SMS and Voice V2 Pool (%s) origination identities: %s", d.Id(), err)
+	}
+
+	d.Set("arn", pool.PoolArn)
+	d.Set("deletion_protection_enabled", pool.DeletionProtectionEnabled)
+	if len(originationIdentities) > 0 {
+		d.Set("iso_country_code", originationIdentities[0].IsoCountryCode)
+	}
+	d.Set("message_type", pool.MessageType)
+	d.Set("opt_out_list_name", pool.OptOutListName)
+	d.Set("origination_identities", flattenOriginationIdentityARNs(originationIdentities))
+	d.Set("self_managed_opt_outs_enabled", pool.SelfManagedOptOutsEnabled)
+	d.Set("shared_routes_enabled", pool.SharedRoutesEnabled)
+	d.Set("two_way_channel_arn", pool.TwoWayChannelArn)
+	d.Set("two_way_enabled", pool.TwoWayEnabled)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Pinpoint SMS and Voice V2 Pool (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	if d.HasChange("origination_identities") {
+		isoCountryCode := d.Get("iso_country_code").(string)
+		o, n := d.GetChange("origination_identities")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Associate before disassociating so that the pool is never left empty.
+		for _, v := range ns.Difference(os).List() {
+			if err := associatePoolOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, v := range os.Difference(ns).List() {
+			if err := disassociatePoolOriginationIdentity(ctx, conn, d.Id(), v.(string), isoCountryCode); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChanges("deletion_protection_enabled", "opt_out_list_name", "self_managed_opt_outs_enabled", "shared_routes_enabled", "two_way_channel_arn", "two_way_enabled") {
+		if err := updatePool(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating tags: %s", err)
+		}
+	}
+
+	return resourcePoolRead(ctx, d, meta)
+}
+
+func resourcePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	log.Printf("[INFO] Deleting Pinpoint SMS and Voice V2 Pool: %s", d.Id())
+	_, err := conn.DeletePoolWithContext(ctx, &pinpointsmsvoicev2.DeletePoolInput{
+		PoolId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Pinpoint SMS and Voice V2 Pool (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPoolDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Pinpoint SMS and Voice V2 Pool (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updatePool(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, d *schema.ResourceData) error {
+	input := &pinpointsmsvoicev2.UpdatePoolInput{
+		DeletionProtectionEnabled: aws.Bool(d.Get("deletion_protection_enabled").(bool)),
+		PoolId:                    aws.String(d.Id()),
+		SelfManagedOptOutsEnabled: aws.Bool(d.Get("self_managed_opt_outs_enabled").(bool)),
+		SharedRoutesEnabled:       aws.Bool(d.Get("shared_routes_enabled").(bool)),
+		TwoWayEnabled:             aws.Bool(d.Get("two_way_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("opt_out_list_name"); ok {
+		input.OptOutListName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("two_way_channel_arn"); ok {
+		input.TwoWayChannelArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Pinpoint SMS and Voice V2 Pool: %s", input)
+	if _, err := conn.UpdatePoolWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating Pinpoint SMS and Voice V2 Pool (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func associatePoolOriginationIdentity(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, poolID, originationIdentity, isoCountryCode string) error {
+	input := &pinpointsmsvoicev2.AssociateOriginationIdentityInput{
+		IsoCountryCode:      aws.String(isoCountryCode),
+		OriginationIdentity: aws.String(originationIdentity),
+		PoolId:              aws.String(poolID),
+	}
+
+	log.Printf("[DEBUG] Associating Pinpoint SMS and Voice V2 Pool origination identity: %s", input)
+	if _, err := conn.AssociateOriginationIdentityWithContext(ctx, input); err != nil {
+		return fmt.Errorf("associating Pinpoint SMS and Voice V2 Pool (%s) origination identity (%s): %w", poolID, originationIdentity, err)
+	}
+
+	return nil
+}
+
+func disassociatePoolOriginationIdentity(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, poolID, originationIdentity, isoCountryCode string) error {
+	input := &pinpointsmsvoicev2.DisassociateOriginationIdentityInput{
+		IsoCountryCode:      aws.String(isoCountryCode),
+		OriginationIdentity: aws.String(originationIdentity),
+		PoolId:              aws.String(poolID),
+	}
+
+	log.Printf("[DEBUG] Disassociating Pinpoint SMS and Voice V2 Pool origination identity: %s", input)
+	_, err := conn.DisassociateOriginationIdentityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpointsmsvoicev2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("disassociating Pinpoint SMS and Voice V2 Pool (%s) origination identity (%s): %w", poolID, originationIdentity, err)
+	}
+
+	return nil
+}
+
+func flattenOriginationIdentityARNs(apiObjects []*pinpointsmsvoicev2.OriginationIdentityMetadata) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.StringValue(apiObject.OriginationIdentityArn))
+	}
+
+	return tfList
+}
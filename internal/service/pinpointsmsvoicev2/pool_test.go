@@ -0,0 +1,198 @@
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSMSVoiceV2Pool_basic(t *testing.T) {
+	var v pinpointsmsvoicev2.PoolInformation
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pinpointsmsvoicev2.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "sms-voice", regexp.MustCompile(`pool/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "iso_country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "message_type", "TRANSACTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "opt_out_list_name", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origination_identities.*", "aws_pinpointsmsvoicev2_phone_number.test.0", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "self_managed_opt_outs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "shared_routes_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "two_way_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2Pool_disappears(t *testing.T) {
+	var v pinpointsmsvoicev2.PoolInformation
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pinpointsmsvoicev2.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpointsmsvoicev2.ResourcePool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2Pool_originationIdentities(t *testing.T) {
+	var v pinpointsmsvoicev2.PoolInformation
+	resourceName := "aws_pinpointsmsvoicev2_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(pinpointsmsvoicev2.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpointsmsvoicev2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "1"),
+				),
+			},
+			{
+				Config: testAccPoolConfig_twoOriginationIdentities(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origination_identities.*", "aws_pinpointsmsvoicev2_phone_number.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origination_identities.*", "aws_pinpointsmsvoicev2_phone_number.test.1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPoolConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "origination_identities.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPoolExists(n string, v *pinpointsmsvoicev2.PoolInformation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint SMS and Voice V2 Pool ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+		output, err := tfpinpointsmsvoicev2.FindPoolByID(context.TODO(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPoolDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpointsmsvoicev2_pool" {
+			continue
+		}
+
+		_, err := tfpinpointsmsvoicev2.FindPoolByID(context.TODO(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint SMS and Voice V2 Pool %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPoolBaseConfig() string {
+	return `
+resource "aws_pinpointsmsvoicev2_phone_number" "test" {
+  count = 2
+
+  iso_country_code    = "US"
+  message_type        = "TRANSACTIONAL"
+  number_type         = "TOLL_FREE"
+  number_capabilities = ["SMS"]
+}
+`
+}
+
+func testAccPoolConfig_basic() string {
+	return acctest.ConfigCompose(testAccPoolBaseConfig(), `
+resource "aws_pinpointsmsvoicev2_pool" "test" {
+  iso_country_code       = "US"
+  message_type           = "TRANSACTIONAL"
+  origination_identities = [aws_pinpointsmsvoicev2_phone_number.test[0].arn]
+}
+`)
+}
+
+func testAccPoolConfig_twoOriginationIdentities() string {
+	return acctest.ConfigCompose(testAccPoolBaseConfig(), `
+resource "aws_pinpointsmsvoicev2_pool" "test" {
+  iso_country_code       = "US"
+  message_type           = "TRANSACTIONAL"
+  origination_identities = aws_pinpointsmsvoicev2_phone_number.test[*].arn
+}
+`)
+}
@@ -0,0 +1,228 @@
+//go:build sweep
+// +build sweep
+
+package pinpointsmsvoicev2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pinpointsmsvoicev2_configuration_set", &resource.Sweeper{
+		Name: "aws_pinpointsmsvoicev2_configuration_set",
+		F:    sweepConfigurationSets,
+	})
+
+	resource.AddTestSweepers("aws_pinpointsmsvoicev2_opt_out_list", &resource.Sweeper{
+		Name: "aws_pinpointsmsvoicev2_opt_out_list",
+		F:    sweepOptOutLists,
+		Dependencies: []string{
+			"aws_pinpointsmsvoicev2_phone_number",
+			"aws_pinpointsmsvoicev2_pool",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pinpointsmsvoicev2_phone_number", &resource.Sweeper{
+		Name: "aws_pinpointsmsvoicev2_phone_number",
+		F:    sweepPhoneNumbers,
+		Dependencies: []string{
+			"aws_pinpointsmsvoicev2_pool",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pinpointsmsvoicev2_pool", &resource.Sweeper{
+		Name: "aws_pinpointsmsvoicev2_pool",
+		F:    sweepPools,
+	})
+}
+
+func sweepConfigurationSets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeConfigurationSetsPages(&pinpointsmsvoicev2.DescribeConfigurationSetsInput{}, func(page *pinpointsmsvoicev2.DescribeConfigurationSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfigurationSets {
+			r := ResourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ConfigurationSetName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Pinpoint SMS and Voice V2 Configuration Sets: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Pinpoint SMS and Voice V2 Configuration Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Pinpoint SMS and Voice V2 Configuration Set sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepOptOutLists(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeOptOutListsPages(&pinpointsmsvoicev2.DescribeOptOutListsInput{}, func(page *pinpointsmsvoicev2.DescribeOptOutListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OptOutLists {
+			name := aws.StringValue(v.OptOutListName)
+
+			// Every account has an undeletable "Default" opt-out list.
+			if name == "Default" {
+				continue
+			}
+
+			r := ResourceOptOutList()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Pinpoint SMS and Voice V2 Opt-Out Lists: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Pinpoint SMS and Voice V2 Opt-Out Lists for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Pinpoint SMS and Voice V2 Opt-Out List sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepPhoneNumbers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribePhoneNumbersPages(&pinpointsmsvoicev2.DescribePhoneNumbersInput{}, func(page *pinpointsmsvoicev2.DescribePhoneNumbersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PhoneNumbers {
+			if aws.StringValue(v.Status) == pinpointsmsvoicev2.NumberStatusDeleted {
+				continue
+			}
+
+			r := ResourcePhoneNumber()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PhoneNumberId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Pinpoint SMS and Voice V2 Phone Numbers: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Pinpoint SMS and Voice V2 Phone Numbers for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Pinpoint SMS and Voice V2 Phone Number sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepPools(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).PinpointSMSVoiceV2Conn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribePoolsPages(&pinpointsmsvoicev2.DescribePoolsInput{}, func(page *pinpointsmsvoicev2.DescribePoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Pools {
+			r := ResourcePool()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PoolId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Pinpoint SMS and Voice V2 Pools: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Pinpoint SMS and Voice V2 Pools for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Pinpoint SMS and Voice V2 Pool sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
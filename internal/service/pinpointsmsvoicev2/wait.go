@@ -0,0 +1,84 @@
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	phoneNumberActiveTimeout  = 5 * time.Minute
+	phoneNumberDeletedTimeout = 5 * time.Minute
+	poolActiveTimeout         = 5 * time.Minute
+	poolDeletedTimeout        = 5 * time.Minute
+)
+
+func waitPhoneNumberActive(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PhoneNumberInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.NumberStatusPending, pinpointsmsvoicev2.NumberStatusAssociating, pinpointsmsvoicev2.NumberStatusDisassociating},
+		Target:  []string{pinpointsmsvoicev2.NumberStatusActive},
+		Refresh: statusPhoneNumber(ctx, conn, id),
+		Timeout: phoneNumberActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PhoneNumberInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPhoneNumberDeleted(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PhoneNumberInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.NumberStatusActive, pinpointsmsvoicev2.NumberStatusAssociating, pinpointsmsvoicev2.NumberStatusDisassociating},
+		Target:  []string{},
+		Refresh: statusPhoneNumber(ctx, conn, id),
+		Timeout: phoneNumberDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PhoneNumberInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolActive(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PoolInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.PoolStatusCreating},
+		Target:  []string{pinpointsmsvoicev2.PoolStatusActive},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: poolActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolDeleted(ctx context.Context, conn *pinpointsmsvoicev2.PinpointSMSVoiceV2, id string) (*pinpointsmsvoicev2.PoolInformation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pinpointsmsvoicev2.PoolStatusActive, pinpointsmsvoicev2.PoolStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: poolDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*pinpointsmsvoicev2.PoolInformation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
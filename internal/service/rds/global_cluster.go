@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const (
@@ -580,7 +581,13 @@ func globalClusterUpgradeMajorEngineVersion(meta interface{}, clusterID string,
 		useConn := conn // clusters may not all be in the same region
 
 		if clusterRegion != meta.(*conns.AWSClient).Region {
-			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
+			v, err := meta.(*conns.AWSClient).RegionalConn(names.RDS, clusterRegion)
+
+			if err != nil {
+				return fmt.Errorf("error creating RDS client for Region (%s): %w", clusterRegion, err)
+			}
+
+			useConn = v.(*rds.RDS)
 		}
 
 		if err := waitForClusterUpdate(useConn, dbi, timeout); err != nil {
@@ -646,7 +653,13 @@ func globalClusterUpgradeMinorEngineVersion(meta interface{}, clusterMembers *sc
 		useConn := conn
 
 		if clusterRegion != meta.(*conns.AWSClient).Region {
-			useConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(clusterRegion))
+			v, err := meta.(*conns.AWSClient).RegionalConn(names.RDS, clusterRegion)
+
+			if err != nil {
+				return fmt.Errorf("error creating RDS client for Region (%s): %w", clusterRegion, err)
+			}
+
+			useConn = v.(*rds.RDS)
 		}
 
 		modInput := &rds.ModifyDBClusterInput{
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceInstanceAutomatedBackupsReplication() *schema.Resource {
@@ -138,7 +139,13 @@ func resourceInstanceAutomatedBackupsReplicationDelete(d *schema.ResourceData, m
 	// Create a new client to the source region.
 	sourceDatabaseConn := conn
 	if sourceDatabaseARN.Region != meta.(*conns.AWSClient).Region {
-		sourceDatabaseConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(sourceDatabaseARN.Region))
+		v, err := meta.(*conns.AWSClient).RegionalConn(names.RDS, sourceDatabaseARN.Region)
+
+		if err != nil {
+			return fmt.Errorf("error creating RDS client for Region (%s): %w", sourceDatabaseARN.Region, err)
+		}
+
+		sourceDatabaseConn = v.(*rds.RDS)
 	}
 
 	if _, err := waitDBInstanceAutomatedBackupDeleted(sourceDatabaseConn, dbInstanceID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
@@ -335,6 +335,12 @@ func ResourceCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"manual_snapshot_retention_period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(-1, 3653),
+						},
 						"retention_period": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -887,20 +893,10 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("snapshot_copy") {
-		if v, ok := d.GetOk("snapshot_copy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			err := enableSnapshotCopy(conn, d.Id(), v.([]interface{})[0].(map[string]interface{}))
-
-			if err != nil {
-				return err
-			}
-		} else {
-			_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
-				ClusterIdentifier: aws.String(d.Id()),
-			})
+		o, n := d.GetChange("snapshot_copy")
 
-			if err != nil {
-				return fmt.Errorf("error disabling Redshift Cluster (%s) snapshot copy: %w", d.Id(), err)
-			}
+		if err := updateSnapshotCopy(conn, d.Id(), o.([]interface{}), n.([]interface{})); err != nil {
+			return err
 		}
 	}
 
@@ -1035,12 +1031,16 @@ func enableSnapshotCopy(conn *redshift.Redshift, clusterID string, tfMap map[str
 		DestinationRegion: aws.String(tfMap["destination_region"].(string)),
 	}
 
+	if v, ok := tfMap["manual_snapshot_retention_period"].(int); ok && v != 0 {
+		input.ManualSnapshotRetentionPeriod = aws.Int64(int64(v))
+	}
+
 	if v, ok := tfMap["retention_period"]; ok {
 		input.RetentionPeriod = aws.Int64(int64(v.(int)))
 	}
 
-	if v, ok := tfMap["grant_name"]; ok {
-		input.SnapshotCopyGrantName = aws.String(v.(string))
+	if v, ok := tfMap["grant_name"].(string); ok && v != "" {
+		input.SnapshotCopyGrantName = aws.String(v)
 	}
 
 	_, err := conn.EnableSnapshotCopy(input)
@@ -1052,6 +1052,74 @@ func enableSnapshotCopy(conn *redshift.Redshift, clusterID string, tfMap map[str
 	return nil
 }
 
+func disableSnapshotCopy(conn *redshift.Redshift, clusterID string) error {
+	_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+		ClusterIdentifier: aws.String(clusterID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disabling Redshift Cluster (%s) snapshot copy: %w", clusterID, err)
+	}
+
+	return nil
+}
+
+// updateSnapshotCopy applies a change to the snapshot_copy configuration.
+// Snapshot copy is already enabled when only the retention periods change, so they are modified in place.
+// A new destination Region or snapshot copy grant requires snapshot copy to be disabled and enabled again.
+func updateSnapshotCopy(conn *redshift.Redshift, clusterID string, o, n []interface{}) error {
+	if len(n) == 0 || n[0] == nil {
+		return disableSnapshotCopy(conn, clusterID)
+	}
+
+	newMap := n[0].(map[string]interface{})
+
+	if len(o) == 0 || o[0] == nil {
+		return enableSnapshotCopy(conn, clusterID, newMap)
+	}
+
+	oldMap := o[0].(map[string]interface{})
+
+	if oldMap["destination_region"].(string) != newMap["destination_region"].(string) || oldMap["grant_name"].(string) != newMap["grant_name"].(string) {
+		if err := disableSnapshotCopy(conn, clusterID); err != nil {
+			return err
+		}
+
+		return enableSnapshotCopy(conn, clusterID, newMap)
+	}
+
+	if o, n := oldMap["retention_period"].(int), newMap["retention_period"].(int); o != n {
+		if err := modifySnapshotCopyRetentionPeriod(conn, clusterID, n, false); err != nil {
+			return err
+		}
+	}
+
+	if o, n := oldMap["manual_snapshot_retention_period"].(int), newMap["manual_snapshot_retention_period"].(int); o != n && n != 0 {
+		if err := modifySnapshotCopyRetentionPeriod(conn, clusterID, n, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func modifySnapshotCopyRetentionPeriod(conn *redshift.Redshift, clusterID string, retentionPeriod int, manual bool) error {
+	input := &redshift.ModifySnapshotCopyRetentionPeriodInput{
+		ClusterIdentifier: aws.String(clusterID),
+		Manual:            aws.Bool(manual),
+		RetentionPeriod:   aws.Int64(int64(retentionPeriod)),
+	}
+
+	log.Printf("[DEBUG] Modifying Redshift Cluster snapshot copy retention period: %s", input)
+	_, err := conn.ModifySnapshotCopyRetentionPeriod(input)
+
+	if err != nil {
+		return fmt.Errorf("error modifying Redshift Cluster (%s) snapshot copy retention period: %w", clusterID, err)
+	}
+
+	return nil
+}
+
 func flattenClusterNode(apiObject *redshift.ClusterNode) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -306,13 +306,23 @@ func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
 		CheckDestroy:      testAccCheckClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterConfig_snapshotCopyEnabled(rName),
+				Config: testAccClusterConfig_snapshotCopyEnabled(rName, 1, 1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(resourceName, &v),
 					resource.TestCheckResourceAttrPair(resourceName, "snapshot_copy.0.destination_region", "data.aws_region.alternate", "name"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_copy.0.manual_snapshot_retention_period", "1"),
 					resource.TestCheckResourceAttr(resourceName, "snapshot_copy.0.retention_period", "1"),
 				),
 			},
+			{
+				Config: testAccClusterConfig_snapshotCopyEnabled(rName, 3, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_copy.0.destination_region", "data.aws_region.alternate", "name"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_copy.0.manual_snapshot_retention_period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_copy.0.retention_period", "3"),
+				),
+			},
 			{
 				Config: testAccClusterConfig_snapshotCopyDisabled(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -1277,7 +1287,7 @@ resource "aws_redshift_cluster" "test" {
 `, rName))
 }
 
-func testAccClusterConfig_snapshotCopyEnabled(rName string) string {
+func testAccClusterConfig_snapshotCopyEnabled(rName string, retentionPeriod, manualSnapshotRetentionPeriod int) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
 		acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"),
@@ -1297,13 +1307,14 @@ resource "aws_redshift_cluster" "test" {
   allow_version_upgrade               = false
 
   snapshot_copy {
-    destination_region = data.aws_region.alternate.name
-    retention_period   = 1
+    destination_region               = data.aws_region.alternate.name
+    retention_period                 = %[2]d
+    manual_snapshot_retention_period = %[3]d
   }
 
   skip_final_snapshot = true
 }
-`, rName))
+`, rName, retentionPeriod, manualSnapshotRetentionPeriod))
 }
 
 func testAccClusterConfig_tags1(rName, tagKey1, tagValue1 string) string {
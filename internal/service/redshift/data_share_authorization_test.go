@@ -0,0 +1,146 @@
+package redshift_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Data shares can only be created with SQL, so these tests require an existing data share
+// in the producer cluster's current account.
+func testAccDataShareAuthorizationPreCheck(t *testing.T) (string, string) {
+	dataShareARN := os.Getenv("REDSHIFT_DATA_SHARE_ARN")
+	if dataShareARN == "" {
+		t.Skip("Environment variable REDSHIFT_DATA_SHARE_ARN is not set")
+	}
+
+	consumerIdentifier := os.Getenv("REDSHIFT_DATA_SHARE_CONSUMER_ACCOUNT_ID")
+	if consumerIdentifier == "" {
+		t.Skip("Environment variable REDSHIFT_DATA_SHARE_CONSUMER_ACCOUNT_ID is not set")
+	}
+
+	return dataShareARN, consumerIdentifier
+}
+
+func TestAccRedshiftDataShareAuthorization_basic(t *testing.T) {
+	dataShareARN, consumerIdentifier := testAccDataShareAuthorizationPreCheck(t)
+	resourceName := "aws_redshift_data_share_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataShareAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(dataShareARN, consumerIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "consumer_identifier", consumerIdentifier),
+					resource.TestCheckResourceAttr(resourceName, "data_share_arn", dataShareARN),
+					resource.TestCheckResourceAttrSet(resourceName, "producer_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareAuthorization_disappears(t *testing.T) {
+	dataShareARN, consumerIdentifier := testAccDataShareAuthorizationPreCheck(t)
+	resourceName := "aws_redshift_data_share_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataShareAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(dataShareARN, consumerIdentifier),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceDataShareAuthorization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareAuthorizationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_data_share_authorization" {
+			continue
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, _, err = tfredshift.FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Data Share Authorization %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataShareAuthorizationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Authorization ID is set")
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn
+
+		_, _, err = tfredshift.FindDataShareAuthorizationByID(conn, dataShareARN, consumerIdentifier)
+
+		return err
+	}
+}
+
+func testAccDataShareAuthorizationConfig_basic(dataShareARN, consumerIdentifier string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_data_share_authorization" "test" {
+  data_share_arn      = %[1]q
+  consumer_identifier = %[2]q
+}
+`, dataShareARN, consumerIdentifier)
+}
@@ -0,0 +1,208 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceDataShareConsumerAssociation associates a data share that has been authorized for this account
+// with the whole account, a specific consumer namespace or a Region.
+func ResourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataShareConsumerAssociationCreate,
+		Read:   resourceDataShareConsumerAssociationRead,
+		Delete: resourceDataShareConsumerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"associate_entire_account": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"consumer_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareConsumerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	associateEntireAccount := d.Get("associate_entire_account").(bool)
+	consumerARN := d.Get("consumer_arn").(string)
+	consumerRegion := d.Get("consumer_region").(string)
+	id := DataShareConsumerAssociationCreateResourceID(dataShareARN, associateEntireAccount, consumerARN, consumerRegion)
+	input := &redshift.AssociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.AssociateEntireAccount = aws.Bool(true)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	if consumerRegion != "" {
+		input.ConsumerRegion = aws.String(consumerRegion)
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Data Share Consumer Association: %s", input)
+	_, err := conn.AssociateDataShareConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Data Share Consumer Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDataShareConsumerAssociationRead(d, meta)
+}
+
+func resourceDataShareConsumerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN, associateEntireAccount, consumerARN, consumerRegion, err := DataShareConsumerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// An association with the entire account is reported with the account ID as consumer.
+	consumerIdentifier := consumerARN
+	if associateEntireAccount {
+		consumerIdentifier = meta.(*conns.AWSClient).AccountID
+	}
+
+	dataShare, association, err := FindDataShareConsumerAssociationByID(conn, dataShareARN, consumerIdentifier, consumerRegion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Share Consumer Association (%s): %w", d.Id(), err)
+	}
+
+	if associateEntireAccount {
+		d.Set("associate_entire_account", true)
+	}
+	d.Set("consumer_arn", consumerARN)
+	d.Set("consumer_region", consumerRegion)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return nil
+}
+
+func resourceDataShareConsumerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN, associateEntireAccount, consumerARN, consumerRegion, err := DataShareConsumerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &redshift.DisassociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.DisassociateEntireAccount = aws.Bool(true)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	if consumerRegion != "" {
+		input.ConsumerRegion = aws.String(consumerRegion)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Consumer Association: %s", d.Id())
+	_, err = conn.DisassociateDataShareConsumer(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Data Share Consumer Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const dataShareConsumerAssociationResourceIDSeparator = ","
+
+func DataShareConsumerAssociationCreateResourceID(dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string) string {
+	parts := []string{dataShareARN, strconv.FormatBool(associateEntireAccount), consumerARN, consumerRegion}
+	id := strings.Join(parts, dataShareConsumerAssociationResourceIDSeparator)
+
+	return id
+}
+
+func DataShareConsumerAssociationParseResourceID(id string) (string, bool, string, string, error) {
+	parts := strings.Split(id, dataShareConsumerAssociationResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" {
+		associateEntireAccount, err := strconv.ParseBool(parts[1])
+
+		if err == nil && (associateEntireAccount || parts[2] != "" || parts[3] != "") {
+			return parts[0], associateEntireAccount, parts[2], parts[3], nil
+		}
+	}
+
+	return "", false, "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA-SHARE-ARN%[2]sASSOCIATE-ENTIRE-ACCOUNT%[2]sCONSUMER-ARN%[2]sCONSUMER-REGION", id, dataShareConsumerAssociationResourceIDSeparator)
+}
@@ -0,0 +1,179 @@
+package redshift_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The data share must already be authorized for the current (consumer) account.
+func testAccDataShareConsumerAssociationPreCheck(t *testing.T) string {
+	dataShareARN := os.Getenv("REDSHIFT_CONSUMER_DATA_SHARE_ARN")
+	if dataShareARN == "" {
+		t.Skip("Environment variable REDSHIFT_CONSUMER_DATA_SHARE_ARN is not set")
+	}
+
+	return dataShareARN
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_associateEntireAccount(t *testing.T) {
+	dataShareARN := testAccDataShareConsumerAssociationPreCheck(t)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_associateEntireAccount(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "associate_entire_account", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data_share_arn", dataShareARN),
+					resource.TestCheckResourceAttrSet(resourceName, "producer_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_consumerRegion(t *testing.T) {
+	dataShareARN := testAccDataShareConsumerAssociationPreCheck(t)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_consumerRegion(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "consumer_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "data_share_arn", dataShareARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_disappears(t *testing.T) {
+	dataShareARN := testAccDataShareConsumerAssociationPreCheck(t)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig_associateEntireAccount(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceDataShareConsumerAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareConsumerAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_data_share_consumer_association" {
+			continue
+		}
+
+		_, _, err := testAccFindDataShareConsumerAssociation(rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Data Share Consumer Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataShareConsumerAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Consumer Association ID is set")
+		}
+
+		_, _, err := testAccFindDataShareConsumerAssociation(rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccFindDataShareConsumerAssociation(id string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	client := acctest.Provider.Meta().(*conns.AWSClient)
+
+	dataShareARN, associateEntireAccount, consumerARN, consumerRegion, err := tfredshift.DataShareConsumerAssociationParseResourceID(id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	consumerIdentifier := consumerARN
+	if associateEntireAccount {
+		consumerIdentifier = client.AccountID
+	}
+
+	return tfredshift.FindDataShareConsumerAssociationByID(client.RedshiftConn, dataShareARN, consumerIdentifier, consumerRegion)
+}
+
+func testAccDataShareConsumerAssociationConfig_associateEntireAccount(dataShareARN string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_data_share_consumer_association" "test" {
+  data_share_arn           = %[1]q
+  associate_entire_account = true
+}
+`, dataShareARN)
+}
+
+func testAccDataShareConsumerAssociationConfig_consumerRegion(dataShareARN string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_redshift_data_share_consumer_association" "test" {
+  data_share_arn  = %[1]q
+  consumer_region = data.aws_region.current.name
+}
+`, dataShareARN)
+}
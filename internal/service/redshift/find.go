@@ -356,3 +356,93 @@ func FindEndpointAccessByName(conn *redshift.Redshift, name string) (*redshift.E
 
 	return output.EndpointAccessList[0], nil
 }
+
+func findDataShareByARN(conn *redshift.Redshift, arn string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(arn),
+	}
+
+	var output []*redshift.DataShare
+
+	err := conn.DescribeDataSharesPages(input, func(page *redshift.DescribeDataSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findDataShareAssociation returns the first association of the data share accepted by the filter.
+// Associations that have been deauthorized or rejected are ignored.
+func findDataShareAssociation(conn *redshift.Redshift, arn string, filter func(*redshift.DataShareAssociation) bool) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, err := findDataShareByARN(conn, arn)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v == nil {
+			continue
+		}
+
+		switch aws.StringValue(v.Status) {
+		case redshift.DataShareStatusDeauthorized, redshift.DataShareStatusRejected:
+			continue
+		}
+
+		if filter(v) {
+			return dataShare, v, nil
+		}
+	}
+
+	return nil, nil, &resource.NotFoundError{}
+}
+
+func FindDataShareAuthorizationByID(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	return findDataShareAssociation(conn, dataShareARN, func(v *redshift.DataShareAssociation) bool {
+		return aws.StringValue(v.ConsumerIdentifier) == consumerIdentifier
+	})
+}
+
+func FindDataShareConsumerAssociationByID(conn *redshift.Redshift, dataShareARN, consumerIdentifier, consumerRegion string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	return findDataShareAssociation(conn, dataShareARN, func(v *redshift.DataShareAssociation) bool {
+		if consumerIdentifier != "" && aws.StringValue(v.ConsumerIdentifier) != consumerIdentifier {
+			return false
+		}
+
+		if consumerRegion != "" && aws.StringValue(v.ConsumerRegion) != consumerRegion {
+			return false
+		}
+
+		return true
+	})
+}
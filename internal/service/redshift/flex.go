@@ -72,6 +72,9 @@ func flattenSnapshotCopy(scs *redshift.ClusterSnapshotCopyStatus) []interface{}
 	if scs.DestinationRegion != nil {
 		cfg["destination_region"] = aws.StringValue(scs.DestinationRegion)
 	}
+	if scs.ManualSnapshotRetentionPeriod != nil {
+		cfg["manual_snapshot_retention_period"] = aws.Int64Value(scs.ManualSnapshotRetentionPeriod)
+	}
 	if scs.RetentionPeriod != nil {
 		cfg["retention_period"] = aws.Int64Value(scs.RetentionPeriod)
 	}
@@ -0,0 +1,18 @@
+package rekognition
+
+// See https://docs.aws.amazon.com/rekognition/latest/APIReference/API_ConnectedHomeSettings.html.
+const (
+	connectedHomeLabelAll     = "ALL"
+	connectedHomeLabelPackage = "PACKAGE"
+	connectedHomeLabelPerson  = "PERSON"
+	connectedHomeLabelPet     = "PET"
+)
+
+func connectedHomeLabel_Values() []string {
+	return []string{
+		connectedHomeLabelAll,
+		connectedHomeLabelPackage,
+		connectedHomeLabelPerson,
+		connectedHomeLabelPet,
+	}
+}
@@ -0,0 +1,36 @@
+package rekognition
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindStreamProcessorByName(ctx context.Context, conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	input := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeStreamProcessorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,845 @@
+package rekognition
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStreamProcessor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamProcessorCreate,
+		ReadWithoutTimeout:   resourceStreamProcessorRead,
+		UpdateWithoutTimeout: resourceStreamProcessorUpdate,
+		DeleteWithoutTimeout: resourceStreamProcessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_sharing_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opt_in": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_video_stream": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+			"notification_channel": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sns_topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_data_stream": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"output.0.kinesis_data_stream", "output.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_destination": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"output.0.kinesis_data_stream", "output.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"regions_of_interest": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bounding_box": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"height": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"left": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"top": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"width": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+								},
+							},
+						},
+						"polygon": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 3,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"y": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connected_home": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"settings.0.connected_home", "settings.0.face_search"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(connectedHomeLabel_Values(), false),
+										},
+									},
+									"min_confidence": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+						"face_search": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"settings.0.connected_home", "settings.0.face_search"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+										),
+									},
+									"face_match_threshold": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStreamProcessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateStreamProcessorInput{
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("data_sharing_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSharingPreference = expandStreamProcessorDataSharingPreference(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("input"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Input = expandStreamProcessorInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_channel"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NotificationChannel = expandStreamProcessorNotificationChannel(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Output = expandStreamProcessorOutput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("regions_of_interest"); ok && len(v.([]interface{})) > 0 {
+		input.RegionsOfInterest = expandRegionsOfInterest(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Settings = expandStreamProcessorSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Rekognition Stream Processor: %s", input)
+	_, err := conn.CreateStreamProcessorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Rekognition Stream Processor (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitStreamProcessorStopped(ctx, conn, d.Id(), streamProcessorCreatedTimeout); err != nil {
+		return diag.Errorf("waiting for Rekognition Stream Processor (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceStreamProcessorRead(ctx, d, meta)
+}
+
+func resourceStreamProcessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	streamProcessor, err := FindStreamProcessorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Stream Processor %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", streamProcessor.StreamProcessorArn)
+	if streamProcessor.DataSharingPreference != nil {
+		if err := d.Set("data_sharing_preference", []interface{}{flattenStreamProcessorDataSharingPreference(streamProcessor.DataSharingPreference)}); err != nil {
+			return diag.Errorf("setting data_sharing_preference: %s", err)
+		}
+	} else {
+		d.Set("data_sharing_preference", nil)
+	}
+	if streamProcessor.Input != nil {
+		if err := d.Set("input", []interface{}{flattenStreamProcessorInput(streamProcessor.Input)}); err != nil {
+			return diag.Errorf("setting input: %s", err)
+		}
+	} else {
+		d.Set("input", nil)
+	}
+	d.Set("kms_key_id", streamProcessor.KmsKeyId)
+	d.Set("name", streamProcessor.Name)
+	if streamProcessor.NotificationChannel != nil {
+		if err := d.Set("notification_channel", []interface{}{flattenStreamProcessorNotificationChannel(streamProcessor.NotificationChannel)}); err != nil {
+			return diag.Errorf("setting notification_channel: %s", err)
+		}
+	} else {
+		d.Set("notification_channel", nil)
+	}
+	if streamProcessor.Output != nil {
+		if err := d.Set("output", []interface{}{flattenStreamProcessorOutput(streamProcessor.Output)}); err != nil {
+			return diag.Errorf("setting output: %s", err)
+		}
+	} else {
+		d.Set("output", nil)
+	}
+	if err := d.Set("regions_of_interest", flattenRegionsOfInterest(streamProcessor.RegionsOfInterest)); err != nil {
+		return diag.Errorf("setting regions_of_interest: %s", err)
+	}
+	d.Set("role_arn", streamProcessor.RoleArn)
+	if streamProcessor.Settings != nil {
+		if err := d.Set("settings", []interface{}{flattenStreamProcessorSettings(streamProcessor.Settings)}); err != nil {
+			return diag.Errorf("setting settings: %s", err)
+		}
+	} else {
+		d.Set("settings", nil)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStreamProcessorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &rekognition.UpdateStreamProcessorInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_sharing_preference") {
+			if v, ok := d.GetOk("data_sharing_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DataSharingPreferenceForUpdate = expandStreamProcessorDataSharingPreference(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("regions_of_interest") {
+			if v, ok := d.GetOk("regions_of_interest"); ok && len(v.([]interface{})) > 0 {
+				input.RegionsOfInterestForUpdate = expandRegionsOfInterest(v.([]interface{}))
+			} else {
+				input.ParametersToDelete = append(input.ParametersToDelete, aws.String(rekognition.StreamProcessorParameterToDeleteRegionsOfInterest))
+			}
+		}
+
+		if d.HasChange("settings.0.connected_home") {
+			if v, ok := d.GetOk("settings.0.connected_home"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SettingsForUpdate = &rekognition.StreamProcessorSettingsForUpdate{
+					ConnectedHomeForUpdate: expandConnectedHomeSettingsForUpdate(v.([]interface{})[0].(map[string]interface{})),
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Rekognition Stream Processor: %s", input)
+		_, err := conn.UpdateStreamProcessorWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Rekognition Stream Processor (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitStreamProcessorUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("waiting for Rekognition Stream Processor (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating tags: %s", err)
+		}
+	}
+
+	return resourceStreamProcessorRead(ctx, d, meta)
+}
+
+func resourceStreamProcessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RekognitionConn
+
+	streamProcessor, err := FindStreamProcessorByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	// A running stream processor cannot be deleted.
+	switch aws.StringValue(streamProcessor.Status) {
+	case rekognition.StreamProcessorStatusRunning, rekognition.StreamProcessorStatusStarting:
+		log.Printf("[INFO] Stopping Rekognition Stream Processor: %s", d.Id())
+		_, err := conn.StopStreamProcessorWithContext(ctx, &rekognition.StopStreamProcessorInput{
+			Name: aws.String(d.Id()),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+			return diag.Errorf("stopping Rekognition Stream Processor (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitStreamProcessorStopped(ctx, conn, d.Id(), streamProcessorStoppedTimeout); err != nil && !tfresource.NotFound(err) {
+			return diag.Errorf("waiting for Rekognition Stream Processor (%s) stop: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Rekognition Stream Processor: %s", d.Id())
+	_, err = conn.DeleteStreamProcessorWithContext(ctx, &rekognition.DeleteStreamProcessorInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitStreamProcessorDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("waiting for Rekognition Stream Processor (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandStreamProcessorDataSharingPreference(tfMap map[string]interface{}) *rekognition.StreamProcessorDataSharingPreference {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.StreamProcessorDataSharingPreference{}
+
+	if v, ok := tfMap["opt_in"].(bool); ok {
+		apiObject.OptIn = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorInput(tfMap map[string]interface{}) *rekognition.StreamProcessorInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.StreamProcessorInput{}
+
+	if v, ok := tfMap["kinesis_video_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisVideoStream = &rekognition.KinesisVideoStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorNotificationChannel(tfMap map[string]interface{}) *rekognition.StreamProcessorNotificationChannel {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.StreamProcessorNotificationChannel{}
+
+	if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+		apiObject.SNSTopicArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorOutput(tfMap map[string]interface{}) *rekognition.StreamProcessorOutput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.StreamProcessorOutput{}
+
+	if v, ok := tfMap["kinesis_data_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisDataStream = &rekognition.KinesisDataStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3Destination := &rekognition.S3Destination{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			s3Destination.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			s3Destination.KeyPrefix = aws.String(v)
+		}
+
+		apiObject.S3Destination = s3Destination
+	}
+
+	return apiObject
+}
+
+func expandRegionsOfInterest(tfList []interface{}) []*rekognition.RegionOfInterest {
+	var apiObjects []*rekognition.RegionOfInterest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rekognition.RegionOfInterest{}
+
+		if v, ok := tfMap["bounding_box"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.BoundingBox = &rekognition.BoundingBox{
+				Height: aws.Float64(tfMap["height"].(float64)),
+				Left:   aws.Float64(tfMap["left"].(float64)),
+				Top:    aws.Float64(tfMap["top"].(float64)),
+				Width:  aws.Float64(tfMap["width"].(float64)),
+			}
+		}
+
+		if v, ok := tfMap["polygon"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.Polygon = append(apiObject.Polygon, &rekognition.Point{
+					X: aws.Float64(tfMap["x"].(float64)),
+					Y: aws.Float64(tfMap["y"].(float64)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandStreamProcessorSettings(tfMap map[string]interface{}) *rekognition.StreamProcessorSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.StreamProcessorSettings{}
+
+	if v, ok := tfMap["connected_home"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		connectedHome := &rekognition.ConnectedHomeSettings{}
+
+		if v, ok := tfMap["labels"].(*schema.Set); ok && v.Len() > 0 {
+			connectedHome.Labels = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["min_confidence"].(float64); ok && v != 0 {
+			connectedHome.MinConfidence = aws.Float64(v)
+		}
+
+		apiObject.ConnectedHome = connectedHome
+	}
+
+	if v, ok := tfMap["face_search"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		faceSearch := &rekognition.FaceSearchSettings{}
+
+		if v, ok := tfMap["collection_id"].(string); ok && v != "" {
+			faceSearch.CollectionId = aws.String(v)
+		}
+
+		if v, ok := tfMap["face_match_threshold"].(float64); ok && v != 0 {
+			faceSearch.FaceMatchThreshold = aws.Float64(v)
+		}
+
+		apiObject.FaceSearch = faceSearch
+	}
+
+	return apiObject
+}
+
+func expandConnectedHomeSettingsForUpdate(tfMap map[string]interface{}) *rekognition.ConnectedHomeSettingsForUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.ConnectedHomeSettingsForUpdate{}
+
+	if v, ok := tfMap["labels"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Labels = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["min_confidence"].(float64); ok && v != 0 {
+		apiObject.MinConfidence = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func flattenStreamProcessorDataSharingPreference(apiObject *rekognition.StreamProcessorDataSharingPreference) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.OptIn; v != nil {
+		tfMap["opt_in"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStreamProcessorInput(apiObject *rekognition.StreamProcessorInput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KinesisVideoStream; v != nil {
+		tfMap["kinesis_video_stream"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenStreamProcessorNotificationChannel(apiObject *rekognition.StreamProcessorNotificationChannel) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SNSTopicArn; v != nil {
+		tfMap["sns_topic_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStreamProcessorOutput(apiObject *rekognition.StreamProcessorOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KinesisDataStream; v != nil {
+		tfMap["kinesis_data_stream"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	if v := apiObject.S3Destination; v != nil {
+		tfMap["s3_destination"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenRegionsOfInterest(apiObjects []*rekognition.RegionOfInterest) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.BoundingBox; v != nil {
+			tfMap["bounding_box"] = []interface{}{map[string]interface{}{
+				"height": aws.Float64Value(v.Height),
+				"left":   aws.Float64Value(v.Left),
+				"top":    aws.Float64Value(v.Top),
+				"width":  aws.Float64Value(v.Width),
+			}}
+		}
+
+		if len(apiObject.Polygon) > 0 {
+			var polygon []interface{}
+
+			for _, v := range apiObject.Polygon {
+				if v == nil {
+					continue
+				}
+
+				polygon = append(polygon, map[string]interface{}{
+					"x": aws.Float64Value(v.X),
+					"y": aws.Float64Value(v.Y),
+				})
+			}
+
+			tfMap["polygon"] = polygon
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenStreamProcessorSettings(apiObject *rekognition.StreamProcessorSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConnectedHome; v != nil {
+		tfMap["connected_home"] = []interface{}{map[string]interface{}{
+			"labels":         aws.StringValueSlice(v.Labels),
+			"min_confidence": aws.Float64Value(v.MinConfidence),
+		}}
+	}
+
+	if v := apiObject.FaceSearch; v != nil {
+		tfMap["face_search"] = []interface{}{map[string]interface{}{
+			"collection_id":        aws.StringValue(v.CollectionId),
+			"face_match_threshold": aws.Float64Value(v.FaceMatchThreshold),
+		}}
+	}
+
+	return tfMap
+}
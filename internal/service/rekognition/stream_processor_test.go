@@ -0,0 +1,467 @@
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRekognitionStreamProcessor_basic(t *testing.T) {
+	var v rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rekognition", regexp.MustCompile(`streamprocessor/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_sharing_preference.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_sharing_preference.0.opt_in", "false"),
+					resource.TestCheckResourceAttr(resourceName, "input.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.kinesis_video_stream.0.arn", "aws_kinesis_video_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_channel.0.sns_topic_arn", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.kinesis_data_stream.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.s3_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.s3_destination.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.s3_destination.0.key_prefix", "inference/"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "settings.0.connected_home.0.labels.*", "PERSON"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.face_search.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_disappears(t *testing.T) {
+	var v rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfrekognition.ResourceStreamProcessor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_tags(t *testing.T) {
+	var v rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStreamProcessorConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccStreamProcessorConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_update(t *testing.T) {
+	var v rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(rekognition.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStreamProcessorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "1"),
+				),
+			},
+			{
+				Config: testAccStreamProcessorConfig_connectedHomeUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "data_sharing_preference.0.opt_in", "true"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.bounding_box.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.bounding_box.0.height", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.1.polygon.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.1.polygon.2.x", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "settings.0.connected_home.0.labels.*", "PACKAGE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "settings.0.connected_home.0.labels.*", "PERSON"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.min_confidence", "80"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStreamProcessorExists(n string, v *rekognition.DescribeStreamProcessorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Stream Processor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+		output, err := tfrekognition.FindStreamProcessorByName(context.TODO(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStreamProcessorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rekognition_stream_processor" {
+			continue
+		}
+
+		_, err := tfrekognition.FindStreamProcessorByName(context.TODO(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Rekognition Stream Processor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccStreamProcessorBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+  media_type              = "video/h264"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "rekognition.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+
+  inline_policy {
+    name = %[1]q
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Action = [
+            "kinesisvideo:GetDataEndpoint",
+            "kinesisvideo:GetMedia",
+          ]
+          Effect   = "Allow"
+          Resource = aws_kinesis_video_stream.test.arn
+        },
+        {
+          Action   = "s3:PutObject"
+          Effect   = "Allow"
+          Resource = "${aws_s3_bucket.test.arn}/*"
+        },
+        {
+          Action   = "sns:Publish"
+          Effect   = "Allow"
+          Resource = aws_sns_topic.test.arn
+        },
+      ]
+    })
+  }
+}
+`, rName)
+}
+
+func testAccStreamProcessorConfig_connectedHome(rName string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  data_sharing_preference {
+    opt_in = false
+  }
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  output {
+    s3_destination {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "inference/"
+    }
+  }
+
+  settings {
+    connected_home {
+      labels = ["PERSON"]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccStreamProcessorConfig_connectedHomeUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  data_sharing_preference {
+    opt_in = true
+  }
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  output {
+    s3_destination {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "inference/"
+    }
+  }
+
+  regions_of_interest {
+    bounding_box {
+      height = 0.5
+      left   = 0.5
+      top    = 0.5
+      width  = 0.5
+    }
+  }
+
+  regions_of_interest {
+    polygon {
+      x = 0.1
+      y = 0.1
+    }
+
+    polygon {
+      x = 0.9
+      y = 0.1
+    }
+
+    polygon {
+      x = 0.5
+      y = 0.9
+    }
+  }
+
+  settings {
+    connected_home {
+      labels         = ["PACKAGE", "PERSON"]
+      min_confidence = 80
+    }
+  }
+}
+`, rName))
+}
+
+func testAccStreamProcessorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  output {
+    s3_destination {
+      bucket = aws_s3_bucket.test.bucket
+    }
+  }
+
+  settings {
+    connected_home {
+      labels = ["PERSON"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccStreamProcessorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  output {
+    s3_destination {
+      bucket = aws_s3_bucket.test.bucket
+    }
+  }
+
+  settings {
+    connected_home {
+      labels = ["PERSON"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
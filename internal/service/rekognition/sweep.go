@@ -0,0 +1,68 @@
+//go:build sweep
+// +build sweep
+
+package rekognition
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_rekognition_stream_processor", &resource.Sweeper{
+		Name: "aws_rekognition_stream_processor",
+		F:    sweepStreamProcessors,
+	})
+}
+
+func sweepStreamProcessors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).RekognitionConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListStreamProcessorsPages(&rekognition.ListStreamProcessorsInput{}, func(resp *rekognition.ListStreamProcessorsOutput, lastPage bool) bool {
+		if len(resp.StreamProcessors) == 0 {
+			log.Print("[DEBUG] No Rekognition Stream Processors to sweep")
+			return !lastPage
+		}
+
+		for _, v := range resp.StreamProcessors {
+			r := ResourceStreamProcessor()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Rekognition Stream Processors: %w", err))
+		// in case work can be done, don't jump out yet
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Rekognition Stream Processors for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Rekognition Stream Processor sweep for %s: %s", region, err)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,78 @@
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	streamProcessorCreatedTimeout = 5 * time.Minute
+	streamProcessorDeletedTimeout = 5 * time.Minute
+	streamProcessorStoppedTimeout = 5 * time.Minute
+	streamProcessorUpdatedTimeout = 5 * time.Minute
+)
+
+// waitStreamProcessorStopped waits for a stream processor to reach the STOPPED state.
+// New stream processors start in the STOPPED state; running ones reach it after StopStreamProcessor.
+func waitStreamProcessorStopped(ctx context.Context, conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.StreamProcessorStatusRunning, rekognition.StreamProcessorStatusStarting, rekognition.StreamProcessorStatusStopping},
+		Target:  []string{rekognition.StreamProcessorStatusStopped},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.StreamProcessorStatusUpdating},
+		Target:  []string{rekognition.StreamProcessorStatusRunning, rekognition.StreamProcessorStatusStopped},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: streamProcessorUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.StreamProcessorStatusStopped, rekognition.StreamProcessorStatusStopping, rekognition.StreamProcessorStatusFailed},
+		Target:  []string{},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: streamProcessorDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,560 @@
+package resiliencehub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// appVersionDraft is the version identifier of an application's unpublished draft.
+const appVersionDraft = "draft"
+
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_template_body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]+$`), "must begin with a letter or number and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"resource_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_registry_app_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"logical_stack_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(resiliencehub.ResourceMappingType_Values(), false),
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"aws_region": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+									"identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(resiliencehub.PhysicalIdentifierType_Values(), false),
+									},
+								},
+							},
+						},
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"terraform_source_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &resiliencehub.CreateAppInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("assessment_schedule"); ok {
+		input.AssessmentSchedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Resilience Hub App: %s", input)
+	output, err := conn.CreateAppWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.App.AppArn))
+
+	var publish bool
+
+	if v, ok := d.GetOk("app_template_body"); ok {
+		if err := putDraftAppVersionTemplate(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		publish = true
+	}
+
+	if v, ok := d.GetOk("resource_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		if err := addDraftAppVersionResourceMappings(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		publish = true
+	}
+
+	if publish {
+		if err := publishAppVersion(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	app, err := FindAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	templateBody, err := FindAppVersionTemplateBody(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub App (%s) template: %s", d.Id(), err)
+	}
+
+	resourceMappings, err := FindAppVersionResourceMappings(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return diag.Errorf("reading Resilience Hub App (%s) resource mappings: %s", d.Id(), err)
+	}
+
+	d.Set("app_template_body", templateBody)
+	d.Set("arn", app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set("description", app.Description)
+	d.Set("name", app.Name)
+	d.Set("policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", aws.Float64Value(app.ResiliencyScore))
+	if err := d.Set("resource_mapping", flattenResourceMappings(resourceMappings)); err != nil {
+		return diag.Errorf("setting resource_mapping: %s", err)
+	}
+	d.Set("status", app.Status)
+
+	tags := KeyValueTags(app.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	if d.HasChanges("assessment_schedule", "description", "policy_arn") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("assessment_schedule") {
+			input.AssessmentSchedule = aws.String(d.Get("assessment_schedule").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("policy_arn") {
+			if v := d.Get("policy_arn").(string); v != "" {
+				input.PolicyArn = aws.String(v)
+			} else {
+				input.ClearResiliencyPolicyArn = aws.Bool(true)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Resilience Hub App: %s", input)
+		_, err := conn.UpdateAppWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	var publish bool
+
+	if d.HasChange("app_template_body") {
+		if err := putDraftAppVersionTemplate(ctx, conn, d.Id(), d.Get("app_template_body").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		publish = true
+	}
+
+	if d.HasChange("resource_mapping") {
+		o, n := d.GetChange("resource_mapping")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			if err := removeDraftAppVersionResourceMappings(ctx, conn, d.Id(), del); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			if err := addDraftAppVersionResourceMappings(ctx, conn, d.Id(), add); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		publish = true
+	}
+
+	if publish {
+		if err := publishAppVersion(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Resilience Hub App (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn
+
+	log.Printf("[INFO] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn: aws.String(d.Id()),
+		// Scheduled assessments would otherwise block deletion.
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putDraftAppVersionTemplate(ctx context.Context, conn *resiliencehub.ResilienceHub, arn, body string) error {
+	_, err := conn.PutDraftAppVersionTemplateWithContext(ctx, &resiliencehub.PutDraftAppVersionTemplateInput{
+		AppArn:          aws.String(arn),
+		AppTemplateBody: aws.String(body),
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting Resilience Hub App (%s) draft template: %w", arn, err)
+	}
+
+	return nil
+}
+
+func addDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, tfList []interface{}) error {
+	_, err := conn.AddDraftAppVersionResourceMappingsWithContext(ctx, &resiliencehub.AddDraftAppVersionResourceMappingsInput{
+		AppArn:           aws.String(arn),
+		ResourceMappings: expandResourceMappings(tfList),
+	})
+
+	if err != nil {
+		return fmt.Errorf("adding Resilience Hub App (%s) draft resource mappings: %w", arn, err)
+	}
+
+	return nil
+}
+
+func removeDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, tfList []interface{}) error {
+	input := &resiliencehub.RemoveDraftAppVersionResourceMappingsInput{
+		AppArn: aws.String(arn),
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		switch tfMap["mapping_type"].(string) {
+		case resiliencehub.ResourceMappingTypeAppRegistryApp:
+			input.AppRegistryAppNames = append(input.AppRegistryAppNames, aws.String(tfMap["app_registry_app_name"].(string)))
+		case resiliencehub.ResourceMappingTypeCfnStack:
+			input.LogicalStackNames = append(input.LogicalStackNames, aws.String(tfMap["logical_stack_name"].(string)))
+		case resiliencehub.ResourceMappingTypeResource:
+			input.ResourceNames = append(input.ResourceNames, aws.String(tfMap["resource_name"].(string)))
+		case resiliencehub.ResourceMappingTypeResourceGroup:
+			input.ResourceGroupNames = append(input.ResourceGroupNames, aws.String(tfMap["resource_group_name"].(string)))
+		case resiliencehub.ResourceMappingTypeTerraform:
+			input.TerraformSourceNames = append(input.TerraformSourceNames, aws.String(tfMap["terraform_source_name"].(string)))
+		}
+	}
+
+	_, err := conn.RemoveDraftAppVersionResourceMappingsWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("removing Resilience Hub App (%s) draft resource mappings: %w", arn, err)
+	}
+
+	return nil
+}
+
+func publishAppVersion(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) error {
+	_, err := conn.PublishAppVersionWithContext(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing Resilience Hub App (%s) version: %w", arn, err)
+	}
+
+	return nil
+}
+
+func expandResourceMappings(tfList []interface{}) []*resiliencehub.ResourceMapping {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*resiliencehub.ResourceMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &resiliencehub.ResourceMapping{
+			MappingType: aws.String(tfMap["mapping_type"].(string)),
+		}
+
+		if v, ok := tfMap["app_registry_app_name"].(string); ok && v != "" {
+			apiObject.AppRegistryAppName = aws.String(v)
+		}
+
+		if v, ok := tfMap["logical_stack_name"].(string); ok && v != "" {
+			apiObject.LogicalStackName = aws.String(v)
+		}
+
+		if v, ok := tfMap["physical_resource_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.PhysicalResourceId = expandPhysicalResourceID(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["resource_group_name"].(string); ok && v != "" {
+			apiObject.ResourceGroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["resource_name"].(string); ok && v != "" {
+			apiObject.ResourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["terraform_source_name"].(string); ok && v != "" {
+			apiObject.TerraformSourceName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPhysicalResourceID(tfMap map[string]interface{}) *resiliencehub.PhysicalResourceId {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &resiliencehub.PhysicalResourceId{
+		Identifier: aws.String(tfMap["identifier"].(string)),
+		Type:       aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["aws_account_id"].(string); ok && v != "" {
+		apiObject.AwsAccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["aws_region"].(string); ok && v != "" {
+		apiObject.AwsRegion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenResourceMappings(apiObjects []*resiliencehub.ResourceMapping) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"app_registry_app_name": aws.StringValue(apiObject.AppRegistryAppName),
+			"logical_stack_name":    aws.StringValue(apiObject.LogicalStackName),
+			"mapping_type":          aws.StringValue(apiObject.MappingType),
+			"physical_resource_id":  flattenPhysicalResourceID(apiObject.PhysicalResourceId),
+			"resource_group_name":   aws.StringValue(apiObject.ResourceGroupName),
+			"resource_name":         aws.StringValue(apiObject.ResourceName),
+			"terraform_source_name": aws.StringValue(apiObject.TerraformSourceName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPhysicalResourceID(apiObject *resiliencehub.PhysicalResourceId) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"aws_account_id": aws.StringValue(apiObject.AwsAccountId),
+		"aws_region":     aws.StringValue(apiObject.AwsRegion),
+		"identifier":     aws.StringValue(apiObject.Identifier),
+		"type":           aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,261 @@
+package resiliencehub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresiliencehub "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccResilienceHubApp_basic(t *testing.T) {
+	var v resiliencehub.App
+	resourceName := "aws_resiliencehub_app.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "resiliencehub", regexp.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "app_template_body"),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", resiliencehub.AppAssessmentScheduleTypeDisabled),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", resiliencehub.AppStatusTypeActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_disappears(t *testing.T) {
+	var v resiliencehub.App
+	resourceName := "aws_resiliencehub_app.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfresiliencehub.ResourceApp(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_policyAndSchedule(t *testing.T) {
+	var v resiliencehub.App
+	resourceName := "aws_resiliencehub_app.test"
+	policyResourceName := "aws_resiliencehub_resiliency_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_policyAndSchedule(rName, "test", resiliencehub.AppAssessmentScheduleTypeDaily),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", resiliencehub.AppAssessmentScheduleTypeDaily),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_arn", policyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_policyAndSchedule(rName, "updated", resiliencehub.AppAssessmentScheduleTypeDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "assessment_schedule", resiliencehub.AppAssessmentScheduleTypeDisabled),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_arn", policyResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAppConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResilienceHubApp_resourceMapping(t *testing.T) {
+	var v resiliencehub.App
+	resourceName := "aws_resiliencehub_app.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resiliencehub.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_resourceMapping(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_mapping.*", map[string]string{
+						"mapping_type":                      resiliencehub.ResourceMappingTypeResource,
+						"physical_resource_id.#":            "1",
+						"physical_resource_id.0.type":       resiliencehub.PhysicalIdentifierTypeNative,
+						"physical_resource_id.0.identifier": rName,
+						"resource_name":                     "bucket",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppExists(n string, v *resiliencehub.App) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resilience Hub App ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+
+		output, err := tfresiliencehub.FindAppByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAppDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResilienceHubConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resiliencehub_app" {
+			continue
+		}
+
+		_, err := tfresiliencehub.FindAppByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Resilience Hub App %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAppConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAppConfig_policyAndSchedule(rName, description, schedule string) string {
+	return acctest.ConfigCompose(testAccResiliencyPolicyConfig_basic(rName, resiliencehub.ResiliencyPolicyTierNonCritical, 3600), fmt.Sprintf(`
+resource "aws_resiliencehub_app" "test" {
+  name                = %[1]q
+  description         = %[2]q
+  assessment_schedule = %[3]q
+  policy_arn          = aws_resiliencehub_resiliency_policy.test.arn
+}
+`, rName, description, schedule))
+}
+
+func testAccAppConfig_resourceMapping(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_resiliencehub_app" "test" {
+  name = %[1]q
+
+  app_template_body = jsonencode({
+    resources = [{
+      logicalResourceId = {
+        identifier = "bucket"
+      }
+      name = "bucket"
+      type = "AWS::S3::Bucket"
+    }]
+    appComponents = [{
+      name          = "storage"
+      type          = "AWS::ResilienceHub::StorageAppComponent"
+      resourceNames = ["bucket"]
+    }]
+    excludedResources = {}
+  })
+
+  resource_mapping {
+    mapping_type  = "Resource"
+    resource_name = "bucket"
+
+    physical_resource_id {
+      identifier = aws_s3_bucket.test.bucket
+      type       = "Native"
+    }
+  }
+}
+`, rName)
+}
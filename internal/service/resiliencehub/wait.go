@@ -0,0 +1,26 @@
+package resiliencehub
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, timeout time.Duration) (*resiliencehub.App, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{resiliencehub.AppStatusTypeActive, resiliencehub.AppStatusTypeDeleting},
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
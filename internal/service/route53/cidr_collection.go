@@ -0,0 +1,113 @@
+package route53
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var cidrCollectionNameRegexp = regexp.MustCompile(`^[0-9A-Za-z_-]+$`)
+
+func ResourceCIDRCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCIDRCollectionCreate,
+		ReadWithoutTimeout:   resourceCIDRCollectionRead,
+		DeleteWithoutTimeout: resourceCIDRCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(cidrCollectionNameRegexp, "must contain only alphanumeric characters, dashes and underscores"),
+				),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCIDRCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	name := d.Get("name").(string)
+	input := &route53.CreateCidrCollectionInput{
+		CallerReference: aws.String(resource.UniqueId()),
+		Name:            aws.String(name),
+	}
+
+	log.Printf("[INFO] Creating Route 53 CIDR Collection: %s", input)
+	output, err := conn.CreateCidrCollectionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 CIDR Collection (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Collection.Id))
+
+	return resourceCIDRCollectionRead(ctx, d, meta)
+}
+
+func resourceCIDRCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collection, err := FindCIDRCollectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 CIDR Collection %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 CIDR Collection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", collection.Arn)
+	d.Set("name", collection.Name)
+	d.Set("version", collection.Version)
+
+	return nil
+}
+
+func resourceCIDRCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	log.Printf("[INFO] Deleting Route 53 CIDR Collection: %s", d.Id())
+	_, err := conn.DeleteCidrCollectionWithContext(ctx, &route53.DeleteCidrCollectionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 CIDR Collection (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
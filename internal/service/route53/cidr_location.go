@@ -0,0 +1,221 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum number of CIDR blocks in a single ChangeCidrCollection change.
+	cidrCollectionChangeMaxCIDRBlocks = 1000
+
+	cidrCollectionVersionMismatchTimeout = 2 * time.Minute
+)
+
+func ResourceCIDRLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCIDRLocationCreate,
+		ReadWithoutTimeout:   resourceCIDRLocationRead,
+		UpdateWithoutTimeout: resourceCIDRLocationUpdate,
+		DeleteWithoutTimeout: resourceCIDRLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"cidr_collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 16),
+					validation.StringMatch(cidrCollectionNameRegexp, "must contain only alphanumeric characters, dashes and underscores"),
+				),
+			},
+		},
+	}
+}
+
+func resourceCIDRLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID := d.Get("cidr_collection_id").(string)
+	name := d.Get("name").(string)
+	id := CIDRLocationCreateResourceID(collectionID, name)
+
+	err := changeCIDRCollection(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionPut, flex.ExpandStringSet(d.Get("cidr_blocks").(*schema.Set)))
+
+	if err != nil {
+		return diag.Errorf("error creating Route 53 CIDR Location (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCIDRLocationRead(ctx, d, meta)
+}
+
+func resourceCIDRLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cidrBlocks, err := FindCIDRBlocksByTwoPartKey(ctx, conn, collectionID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 CIDR Location %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("cidr_collection_id", collectionID)
+	d.Set("name", name)
+
+	return nil
+}
+
+func resourceCIDRLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("cidr_blocks") {
+		o, n := d.GetChange("cidr_blocks")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Add before removing so that the location, which exists only while it has CIDR blocks, is never empty.
+		if add := ns.Difference(os); add.Len() > 0 {
+			if err := changeCIDRCollection(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionPut, flex.ExpandStringSet(add)); err != nil {
+				return diag.Errorf("error adding CIDR blocks to Route 53 CIDR Location (%s): %s", d.Id(), err)
+			}
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			if err := changeCIDRCollection(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionDeleteIfExists, flex.ExpandStringSet(del)); err != nil {
+				return diag.Errorf("error removing CIDR blocks from Route 53 CIDR Location (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceCIDRLocationRead(ctx, d, meta)
+}
+
+func resourceCIDRLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cidrBlocks, err := FindCIDRBlocksByTwoPartKey(ctx, conn, collectionID, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Route 53 CIDR Location: %s", d.Id())
+	err = changeCIDRCollection(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionDeleteIfExists, aws.StringSlice(cidrBlocks))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// changeCIDRCollection applies the specified action to the CIDR blocks of a location, in batches of at most
+// cidrCollectionChangeMaxCIDRBlocks. Each batch is sent with the collection's current version so that
+// concurrent changes to other locations in the same collection are retried rather than overwritten.
+func changeCIDRCollection(ctx context.Context, conn *route53.Route53, collectionID, locationName, action string, cidrBlocks []*string) error {
+	for len(cidrBlocks) > 0 {
+		n := len(cidrBlocks)
+		if n > cidrCollectionChangeMaxCIDRBlocks {
+			n = cidrCollectionChangeMaxCIDRBlocks
+		}
+		batch := cidrBlocks[:n]
+		cidrBlocks = cidrBlocks[n:]
+
+		input := &route53.ChangeCidrCollectionInput{
+			Changes: []*route53.CidrCollectionChange{{
+				Action:       aws.String(action),
+				CidrList:     batch,
+				LocationName: aws.String(locationName),
+			}},
+			Id: aws.String(collectionID),
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, cidrCollectionVersionMismatchTimeout, func() (interface{}, error) {
+			collection, err := FindCIDRCollectionByID(ctx, conn, collectionID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			input.CollectionVersion = collection.Version
+
+			log.Printf("[DEBUG] Changing Route 53 CIDR Collection: %s", input)
+			return conn.ChangeCidrCollectionWithContext(ctx, input)
+		}, route53.ErrCodeCidrCollectionVersionMismatchException)
+
+		if err != nil {
+			return err
+		}
+
+		if changeID := aws.StringValue(outputRaw.(*route53.ChangeCidrCollectionOutput).Id); changeID != "" {
+			if _, err := waitChangeInfoStatusInsync(conn, changeID); err != nil {
+				return fmt.Errorf("error waiting for Route 53 CIDR Collection (%s) change (%s): %w", collectionID, changeID, err)
+			}
+		}
+	}
+
+	return nil
+}
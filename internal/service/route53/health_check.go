@@ -1,6 +1,7 @@
 package route53
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -8,9 +9,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -152,17 +153,8 @@ func ResourceHealthCheck() *schema.Resource {
 				MinItems: 3,
 				MaxItems: 64,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{
-						endpoints.UsWest1RegionID,
-						endpoints.UsWest2RegionID,
-						endpoints.UsEast1RegionID,
-						endpoints.EuWest1RegionID,
-						endpoints.SaEast1RegionID,
-						endpoints.ApSoutheast1RegionID,
-						endpoints.ApSoutheast2RegionID,
-						endpoints.ApNortheast1RegionID,
-					}, true),
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(route53.HealthCheckRegion_Values(), true),
 				},
 				Optional: true,
 			},
@@ -184,7 +176,10 @@ func ResourceHealthCheck() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceHealthCheckCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -405,7 +400,11 @@ func resourceHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if d.HasChange("child_healthchecks") {
-			updateHealthCheck.ChildHealthChecks = flex.ExpandStringSet(d.Get("child_healthchecks").(*schema.Set))
+			if v := d.Get("child_healthchecks").(*schema.Set); v.Len() > 0 {
+				updateHealthCheck.ChildHealthChecks = flex.ExpandStringSet(v)
+			} else {
+				updateHealthCheck.ResetElements = append(updateHealthCheck.ResetElements, aws.String(route53.ResettableElementNameChildHealthChecks))
+			}
 		}
 
 		if d.HasChange("child_health_threshold") {
@@ -434,7 +433,11 @@ func resourceHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if d.HasChange("regions") {
-			updateHealthCheck.Regions = flex.ExpandStringSet(d.Get("regions").(*schema.Set))
+			if v := d.Get("regions").(*schema.Set); v.Len() > 0 {
+				updateHealthCheck.Regions = flex.ExpandStringSet(v)
+			} else {
+				updateHealthCheck.ResetElements = append(updateHealthCheck.ResetElements, aws.String(route53.ResettableElementNameRegions))
+			}
 		}
 
 		if d.HasChange("disabled") {
@@ -479,3 +482,27 @@ func resourceHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceHealthCheckCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	healthCheckType := strings.ToUpper(diff.Get("type").(string))
+
+	switch healthCheckType {
+	case route53.HealthCheckTypeCalculated, route53.HealthCheckTypeCloudwatchMetric, route53.HealthCheckTypeRecoveryControl:
+		// Only endpoint health checks are performed by Route 53 health checkers in specific Regions.
+		if v, ok := diff.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+			return fmt.Errorf("regions cannot be configured for %s health checks", healthCheckType)
+		}
+
+		if diff.Get("measure_latency").(bool) {
+			return fmt.Errorf("measure_latency cannot be enabled for %s health checks", healthCheckType)
+		}
+	}
+
+	if healthCheckType != "" && healthCheckType != route53.HealthCheckTypeCalculated {
+		if v, ok := diff.GetOk("child_healthchecks"); ok && v.(*schema.Set).Len() > 0 {
+			return fmt.Errorf("child_healthchecks can only be configured for %s health checks", route53.HealthCheckTypeCalculated)
+		}
+	}
+
+	return nil
+}
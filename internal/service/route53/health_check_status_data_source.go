@@ -0,0 +1,150 @@
+package route53
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceHealthCheckStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHealthCheckStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"health_check_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"observations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checked_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHealthCheckStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53Conn
+
+	healthCheckID := d.Get("health_check_id").(string)
+	observations, err := FindHealthCheckObservationsByID(ctx, conn, healthCheckID)
+
+	if err != nil {
+		return diag.Errorf("reading Route53 Health Check (%s) status: %s", healthCheckID, err)
+	}
+
+	d.SetId(healthCheckID)
+	d.Set("healthy", healthCheckObservationsHealthy(observations))
+	if err := d.Set("observations", flattenHealthCheckObservations(observations)); err != nil {
+		return diag.Errorf("setting observations: %s", err)
+	}
+
+	return nil
+}
+
+func FindHealthCheckObservationsByID(ctx context.Context, conn *route53.Route53, id string) ([]*route53.HealthCheckObservation, error) {
+	input := &route53.GetHealthCheckStatusInput{
+		HealthCheckId: aws.String(id),
+	}
+
+	output, err := conn.GetHealthCheckStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHealthCheck) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HealthCheckObservations, nil
+}
+
+// healthCheckObservationsHealthy returns whether the endpoint is considered healthy.
+// Route 53 considers an endpoint healthy when more than 18% of health checkers report it healthy.
+func healthCheckObservationsHealthy(apiObjects []*route53.HealthCheckObservation) bool {
+	var healthy int
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.StatusReport == nil {
+			continue
+		}
+
+		if strings.HasPrefix(aws.StringValue(apiObject.StatusReport.Status), "Success") {
+			healthy++
+		}
+	}
+
+	return len(apiObjects) > 0 && healthy*100 > len(apiObjects)*18
+}
+
+func flattenHealthCheckObservations(apiObjects []*route53.HealthCheckObservation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"ip_address": aws.StringValue(apiObject.IPAddress),
+			"region":     aws.StringValue(apiObject.Region),
+		}
+
+		if v := apiObject.StatusReport; v != nil {
+			if v.CheckedTime != nil {
+				tfMap["checked_time"] = aws.TimeValue(v.CheckedTime).Format(time.RFC3339)
+			}
+
+			tfMap["status"] = aws.StringValue(v.Status)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,48 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53HealthCheckStatusDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_health_check_status.test"
+	resourceName := "aws_route53_health_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthCheckStatusDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "healthy"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "observations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHealthCheckStatusDataSourceConfig_basic() string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "test" {
+  fqdn              = %[1]q
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "2"
+  request_interval  = "30"
+}
+
+data "aws_route53_health_check_status" "test" {
+  health_check_id = aws_route53_health_check.test.id
+}
+`, acctest.RandomDomainName())
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53"
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
@@ -157,6 +158,57 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_childHealthChecksUpdate(t *testing.T) {
+	var check1, check2, check3 route53.HealthCheck
+	resourceName := "aws_route53_health_check.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckHealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthCheckConfig_childCount(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(resourceName, &check1),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "2"),
+				),
+			},
+			{
+				Config: testAccHealthCheckConfig_childCount(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(resourceName, &check2),
+					testAccCheckHealthCheckNotRecreated(&check1, &check2),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "5"),
+				),
+			},
+			{
+				Config: testAccHealthCheckConfig_childCount(0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(resourceName, &check3),
+					testAccCheckHealthCheckNotRecreated(&check1, &check3),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53HealthCheck_regionsInvalidType(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckHealthCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_regionsCalculated,
+				ExpectError: regexp.MustCompile(`regions cannot be configured for CALCULATED health checks`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withHealthCheckRegions(t *testing.T) {
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
@@ -418,6 +470,16 @@ func testAccCheckHealthCheckDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckHealthCheckNotRecreated(before, after *route53.HealthCheck) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Id), aws.StringValue(after.Id); before != after {
+			return fmt.Errorf("Route53 Health Check (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckHealthCheckExists(n string, v *route53.HealthCheck) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -535,6 +597,36 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+func testAccHealthCheckConfig_childCount(count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "child" {
+  count = 5
+
+  fqdn              = "child${count.index}.example.com"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "2"
+  request_interval  = "30"
+}
+
+resource "aws_route53_health_check" "test" {
+  type                   = "CALCULATED"
+  child_health_threshold = 0
+  child_healthchecks     = slice(aws_route53_health_check.child[*].id, 0, %[1]d)
+}
+`, count)
+}
+
+const testAccHealthCheckConfig_regionsCalculated = `
+resource "aws_route53_health_check" "test" {
+  type                   = "CALCULATED"
+  child_health_threshold = 0
+
+  regions = ["us-west-2", "us-east-1", "eu-west-1"]
+}
+`
+
 func testAccHealthCheckConfig_regions(regions ...string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
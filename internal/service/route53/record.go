@@ -117,10 +117,36 @@ func ResourceRecord() *schema.Resource {
 				Set: resourceAliasRecordHash,
 			},
 
+			"cidr_routing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"failover_routing_policy",
+					"geolocation_routing_policy",
+					"latency_routing_policy",
+					"multivalue_answer_routing_policy",
+					"weighted_routing_policy",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"failover_routing_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -147,6 +173,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"weighted_routing_policy",
@@ -166,6 +193,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -193,6 +221,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -212,6 +241,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -551,6 +581,16 @@ func resourceRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("ttl", record.TTL)
 
+	if record.CidrRoutingConfig != nil {
+		v := []map[string]interface{}{{
+			"collection_id": aws.StringValue(record.CidrRoutingConfig.CollectionId),
+			"location_name": aws.StringValue(record.CidrRoutingConfig.LocationName),
+		}}
+		if err := d.Set("cidr_routing_policy", v); err != nil {
+			return fmt.Errorf("Error setting CIDR routing records for: %s, error: %w", d.Id(), err)
+		}
+	}
+
 	if record.Failover != nil {
 		v := []map[string]interface{}{{
 			"type": aws.StringValue(record.Failover),
@@ -819,6 +859,18 @@ func resourceRecordBuildSet(d *schema.ResourceData, zoneName string) (*route53.R
 		}
 	}
 
+	if v, ok := d.GetOk("cidr_routing_policy"); ok {
+		if _, ok := d.GetOk("set_identifier"); !ok {
+			return nil, fmt.Errorf(`provider.aws: aws_route53_record: %s: "set_identifier": required field is not set when "cidr_routing_policy" is set`, d.Get("name").(string))
+		}
+		cidr := v.([]interface{})[0].(map[string]interface{})
+
+		rec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(cidr["collection_id"].(string)),
+			LocationName: aws.String(cidr["location_name"].(string)),
+		}
+	}
+
 	if v, ok := d.GetOk("failover_routing_policy"); ok {
 		if _, ok := d.GetOk("set_identifier"); !ok {
 			return nil, fmt.Errorf(`provider.aws: aws_route53_record: %s: "set_identifier": required field is not set when "failover_routing_policy" is set`, d.Get("name").(string))
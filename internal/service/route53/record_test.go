@@ -722,6 +722,39 @@ func TestAccRoute53Record_Geolocation_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_CIDR_basic(t *testing.T) {
+	var record1, record2 route53.ResourceRecordSet
+	resourceName := "aws_route53_record.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	locationName := sdkacctest.RandString(16)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_cidr(rName, locationName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(resourceName, &record1),
+					testAccCheckRecordExists("aws_route53_record.default", &record2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.collection_id", "aws_route53_cidr_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.0.location_name", locationName),
+					resource.TestCheckResourceAttr("aws_route53_record.default", "cidr_routing_policy.0.location_name", "*"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_HealthCheckID_setIdentifierChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.test"
@@ -1631,6 +1664,54 @@ resource "aws_route53_record" "denmark" {
 }
 `
 
+func testAccRecordConfig_cidr(rName, locationName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[2]q
+  cidr_blocks        = ["200.5.3.0/24", "200.6.3.0/24"]
+}
+
+resource "aws_route53_zone" "main" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = "5"
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = aws_route53_cidr_location.test.name
+  }
+
+  set_identifier = "cidr-location"
+  records        = ["cidr.domain.test"]
+}
+
+resource "aws_route53_record" "default" {
+  zone_id = aws_route53_zone.main.zone_id
+  name    = "www"
+  type    = "CNAME"
+  ttl     = "5"
+
+  cidr_routing_policy {
+    collection_id = aws_route53_cidr_collection.test.id
+    location_name = "*"
+  }
+
+  set_identifier = "cidr-default"
+  records        = ["default.domain.test"]
+}
+`, rName, locationName)
+}
+
 func testAccRecordConfig_latencyCNAME(firstRegion, secondRegion, thirdRegion string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_zone" "main" {
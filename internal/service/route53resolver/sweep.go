@@ -251,8 +251,14 @@ func sweepFirewallDomainLists(region string) error {
 			return !lastPage
 		}
 
-		for _, queryLogConfig := range page.FirewallDomainLists {
-			id := aws.StringValue(queryLogConfig.Id)
+		for _, firewallDomainList := range page.FirewallDomainLists {
+			id := aws.StringValue(firewallDomainList.Id)
+
+			// AWS managed domain lists cannot be deleted.
+			if v := aws.StringValue(firewallDomainList.ManagedOwnerName); v != "" {
+				log.Printf("[INFO] Skipping Route53 Resolver DNS Firewall domain list %s: managed by %s", id, v)
+				continue
+			}
 
 			log.Printf("[INFO] Deleting Route53 Resolver DNS Firewall domain list: %s", id)
 			r := ResourceFirewallDomainList()
@@ -363,6 +369,12 @@ func sweepFirewallRuleGroups(region string) error {
 		for _, firewallRuleGroup := range page.FirewallRuleGroups {
 			id := aws.StringValue(firewallRuleGroup.Id)
 
+			// Rule groups shared from another account can only be deleted by their owner.
+			if aws.StringValue(firewallRuleGroup.ShareStatus) == route53resolver.ShareStatusSharedWithMe {
+				log.Printf("[INFO] Skipping Route53 Resolver DNS Firewall rule group %s: shared with this account", id)
+				continue
+			}
+
 			log.Printf("[INFO] Deleting Route53 Resolver DNS Firewall rule group: %s", id)
 			r := ResourceFirewallRuleGroup()
 			d := r.Data(nil)
@@ -409,11 +421,17 @@ func sweepFirewallRules(region string) error {
 
 			ruleGroupId := aws.StringValue(ruleGroup.Id)
 
+			// Rules in rule groups shared from another account cannot be deleted.
+			if aws.StringValue(ruleGroup.ShareStatus) == route53resolver.ShareStatusSharedWithMe {
+				log.Printf("[INFO] Skipping Route53 Resolver DNS Firewall rules in rule group %s: shared with this account", ruleGroupId)
+				continue
+			}
+
 			input := &route53resolver.ListFirewallRulesInput{
 				FirewallRuleGroupId: ruleGroup.Id,
 			}
 
-			err = conn.ListFirewallRulesPages(input, func(page *route53resolver.ListFirewallRulesOutput, lastPage bool) bool {
+			err := conn.ListFirewallRulesPages(input, func(page *route53resolver.ListFirewallRulesOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
@@ -446,8 +464,6 @@ func sweepFirewallRules(region string) error {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall rules for rule group (%s): %w", ruleGroupId, err))
 				continue
 			}
-
-			return !lastPage
 		}
 
 		return !lastPage
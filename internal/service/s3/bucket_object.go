@@ -58,7 +58,6 @@ func ResourceBucketObject() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
 			},
 			"bucket": {
-				Deprecated:   "Use the aws_s3_object resource instead",
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
@@ -115,7 +114,6 @@ func ResourceBucketObject() *schema.Resource {
 				Default:  false,
 			},
 			"key": {
-				Deprecated:   "Use the aws_s3_object resource instead",
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
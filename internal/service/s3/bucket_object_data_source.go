@@ -29,9 +29,8 @@ func DataSourceBucketObject() *schema.Resource {
 				Computed: true,
 			},
 			"bucket": {
-				Deprecated: "Use the aws_s3_object data source instead",
-				Type:       schema.TypeString,
-				Required:   true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"bucket_key_enabled": {
 				Type:     schema.TypeBool,
@@ -19,9 +19,8 @@ func DataSourceBucketObjects() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
-				Deprecated: "Use the aws_s3_objects data source instead",
-				Type:       schema.TypeString,
-				Required:   true,
+				Type:     schema.TypeString,
+				Required: true,
 			},
 			"prefix": {
 				Type:     schema.TypeString,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceMultiRegionAccessPoint() *schema.Resource {
@@ -263,13 +264,13 @@ func ConnForMRAP(client *conns.AWSClient) (*s3control.S3Control, error) {
 		return originalConn, nil
 	}
 
-	sess, err := conns.NewSessionForRegion(&originalConn.Config, region, client.TerraformVersion)
+	v, err := client.RegionalConn(names.S3Control, region)
 
 	if err != nil {
-		return nil, fmt.Errorf("error creating AWS session: %w", err)
+		return nil, fmt.Errorf("error creating S3 Control client for Region (%s): %w", region, err)
 	}
 
-	return s3control.New(sess), nil
+	return v.(*s3control.S3Control), nil
 }
 
 const multiRegionAccessPointResourceIDSeparator = ":"
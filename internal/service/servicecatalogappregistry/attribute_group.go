@@ -0,0 +1,186 @@
+package servicecatalogappregistry
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAttributeGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAttributeGroupCreate,
+		ReadWithoutTimeout:   resourceAttributeGroupRead,
+		UpdateWithoutTimeout: resourceAttributeGroupUpdate,
+		DeleteWithoutTimeout: resourceAttributeGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAttributeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appregistry.CreateAttributeGroupInput{
+		Attributes:  aws.String(d.Get("attributes").(string)),
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Attribute Group: %s", input)
+	output, err := conn.CreateAttributeGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Service Catalog AppRegistry Attribute Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AttributeGroup.Id))
+
+	return resourceAttributeGroupRead(ctx, d, meta)
+}
+
+func resourceAttributeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	attributeGroup, err := FindAttributeGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Attribute Group %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Service Catalog AppRegistry Attribute Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", attributeGroup.Arn)
+	d.Set("attributes", attributeGroup.Attributes)
+	d.Set("description", attributeGroup.Description)
+	d.Set("name", attributeGroup.Name)
+
+	tags := KeyValueTags(attributeGroup.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAttributeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appregistry.UpdateAttributeGroupInput{
+			AttributeGroup: aws.String(d.Id()),
+		}
+
+		if d.HasChange("attributes") {
+			input.Attributes = aws.String(d.Get("attributes").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog AppRegistry Attribute Group: %s", input)
+		_, err := conn.UpdateAttributeGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Service Catalog AppRegistry Attribute Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Service Catalog AppRegistry Attribute Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAttributeGroupRead(ctx, d, meta)
+}
+
+func resourceAttributeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	log.Printf("[INFO] Deleting Service Catalog AppRegistry Attribute Group: %s", d.Id())
+	_, err := conn.DeleteAttributeGroupWithContext(ctx, &appregistry.DeleteAttributeGroupInput{
+		AttributeGroup: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Service Catalog AppRegistry Attribute Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
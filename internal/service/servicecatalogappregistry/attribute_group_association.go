@@ -0,0 +1,136 @@
+package servicecatalogappregistry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAttributeGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAttributeGroupAssociationCreate,
+		ReadWithoutTimeout:   resourceAttributeGroupAssociationRead,
+		DeleteWithoutTimeout: resourceAttributeGroupAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attribute_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAttributeGroupAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	applicationID := d.Get("application_id").(string)
+	attributeGroupID := d.Get("attribute_group_id").(string)
+	id := AttributeGroupAssociationCreateResourceID(applicationID, attributeGroupID)
+	input := &appregistry.AssociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Attribute Group Association: %s", input)
+	_, err := conn.AssociateAttributeGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Service Catalog AppRegistry Attribute Group Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAttributeGroupAssociationRead(ctx, d, meta)
+}
+
+func resourceAttributeGroupAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	applicationID, attributeGroupID, err := AttributeGroupAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindAttributeGroupAssociationByTwoPartKey(ctx, conn, applicationID, attributeGroupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Attribute Group Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Service Catalog AppRegistry Attribute Group Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("attribute_group_id", attributeGroupID)
+
+	return nil
+}
+
+func resourceAttributeGroupAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	applicationID, attributeGroupID, err := AttributeGroupAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Service Catalog AppRegistry Attribute Group Association: %s", d.Id())
+	_, err = conn.DisassociateAttributeGroupWithContext(ctx, &appregistry.DisassociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Service Catalog AppRegistry Attribute Group Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const attributeGroupAssociationResourceIDSeparator = ","
+
+func AttributeGroupAssociationCreateResourceID(applicationID, attributeGroupID string) string {
+	parts := []string{applicationID, attributeGroupID}
+	id := strings.Join(parts, attributeGroupAssociationResourceIDSeparator)
+
+	return id
+}
+
+func AttributeGroupAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, attributeGroupAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sATTRIBUTE-GROUP-ID", id, attributeGroupAssociationResourceIDSeparator)
+}
@@ -0,0 +1,143 @@
+package servicecatalogappregistry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceCatalogAppRegistryAttributeGroupAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group_association.test"
+	applicationResourceName := "aws_servicecatalogappregistry_application.test"
+	attributeGroupResourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appregistry.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAttributeGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttributeGroupAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", applicationResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "attribute_group_id", attributeGroupResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroupAssociation_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_attribute_group_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appregistry.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAttributeGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicecatalogappregistry.ResourceAttributeGroupAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAttributeGroupAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Attribute Group Association ID is set")
+		}
+
+		applicationID, attributeGroupID, err := tfservicecatalogappregistry.AttributeGroupAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+		_, err = tfservicecatalogappregistry.FindAttributeGroupAssociationByTwoPartKey(context.Background(), conn, applicationID, attributeGroupID)
+
+		return err
+	}
+}
+
+func testAccCheckAttributeGroupAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_attribute_group_association" {
+			continue
+		}
+
+		applicationID, attributeGroupID, err := tfservicecatalogappregistry.AttributeGroupAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfservicecatalogappregistry.FindAttributeGroupAssociationByTwoPartKey(context.Background(), conn, applicationID, attributeGroupID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Attribute Group Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAttributeGroupAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    environment = "test"
+  })
+}
+
+resource "aws_servicecatalogappregistry_attribute_group_association" "test" {
+  application_id     = aws_servicecatalogappregistry_application.test.id
+  attribute_group_id = aws_servicecatalogappregistry_attribute_group.test.id
+}
+`, rName)
+}
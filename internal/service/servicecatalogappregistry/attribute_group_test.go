@@ -0,0 +1,139 @@
+package servicecatalogappregistry_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_basic(t *testing.T) {
+	var v appregistry.GetAttributeGroupOutput
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appregistry.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`/attribute-groups/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"environment":"production"}`),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"environment":"staging"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_disappears(t *testing.T) {
+	var v appregistry.GetAttributeGroupOutput
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appregistry.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicecatalogappregistry.ResourceAttributeGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAttributeGroupExists(n string, v *appregistry.GetAttributeGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Attribute Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+		output, err := tfservicecatalogappregistry.FindAttributeGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAttributeGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_attribute_group" {
+			continue
+		}
+
+		_, err := tfservicecatalogappregistry.FindAttributeGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Attribute Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAttributeGroupConfig_basic(rName, environment string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    environment = %[2]q
+  })
+}
+`, rName, environment)
+}
@@ -0,0 +1,128 @@
+package servicecatalogappregistry
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindApplicationByID(ctx context.Context, conn *appregistry.AppRegistry, id string) (*appregistry.GetApplicationOutput, error) {
+	input := &appregistry.GetApplicationInput{
+		Application: aws.String(id),
+	}
+
+	output, err := conn.GetApplicationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Id == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAttributeGroupByID(ctx context.Context, conn *appregistry.AppRegistry, id string) (*appregistry.GetAttributeGroupOutput, error) {
+	input := &appregistry.GetAttributeGroupInput{
+		AttributeGroup: aws.String(id),
+	}
+
+	output, err := conn.GetAttributeGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Id == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAttributeGroupAssociationByTwoPartKey(ctx context.Context, conn *appregistry.AppRegistry, applicationID, attributeGroupID string) (*string, error) {
+	input := &appregistry.ListAssociatedAttributeGroupsInput{
+		Application: aws.String(applicationID),
+	}
+	var output *string
+
+	err := conn.ListAssociatedAttributeGroupsPagesWithContext(ctx, input, func(page *appregistry.ListAssociatedAttributeGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttributeGroups {
+			if aws.StringValue(v) == attributeGroupID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindResourceAssociationByThreePartKey(ctx context.Context, conn *appregistry.AppRegistry, applicationID, resourceType, resourceName string) (*appregistry.Resource, error) {
+	input := &appregistry.GetAssociatedResourceInput{
+		Application:  aws.String(applicationID),
+		Resource:     aws.String(resourceName),
+		ResourceType: aws.String(resourceType),
+	}
+
+	output, err := conn.GetAssociatedResourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Resource == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Resource, nil
+}
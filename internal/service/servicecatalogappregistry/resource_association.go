@@ -0,0 +1,153 @@
+package servicecatalogappregistry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceAssociationCreate,
+		ReadWithoutTimeout:   resourceResourceAssociationRead,
+		DeleteWithoutTimeout: resourceResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appregistry.ResourceTypeCfnStack,
+				ValidateFunc: validation.StringInSlice(appregistry.ResourceType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceResourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	applicationID := d.Get("application_id").(string)
+	resourceType := d.Get("resource_type").(string)
+	resourceName := d.Get("resource").(string)
+	id := ResourceAssociationCreateResourceID(applicationID, resourceType, resourceName)
+	input := &appregistry.AssociateResourceInput{
+		Application:  aws.String(applicationID),
+		Resource:     aws.String(resourceName),
+		ResourceType: aws.String(resourceType),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Resource Association: %s", input)
+	_, err := conn.AssociateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Service Catalog AppRegistry Resource Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceResourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	applicationID, resourceType, resourceName, err := ResourceAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resource, err := FindResourceAssociationByThreePartKey(ctx, conn, applicationID, resourceType, resourceName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Resource Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Service Catalog AppRegistry Resource Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("resource", resourceName)
+	d.Set("resource_arn", resource.Arn)
+	d.Set("resource_type", resourceType)
+
+	return nil
+}
+
+func resourceResourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	applicationID, resourceType, resourceName, err := ResourceAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Service Catalog AppRegistry Resource Association: %s", d.Id())
+	_, err = conn.DisassociateResourceWithContext(ctx, &appregistry.DisassociateResourceInput{
+		Application:  aws.String(applicationID),
+		Resource:     aws.String(resourceName),
+		ResourceType: aws.String(resourceType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Service Catalog AppRegistry Resource Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const resourceAssociationResourceIDSeparator = ","
+
+func ResourceAssociationCreateResourceID(applicationID, resourceType, resourceName string) string {
+	parts := []string{applicationID, resourceType, resourceName}
+	id := strings.Join(parts, resourceAssociationResourceIDSeparator)
+
+	return id
+}
+
+func ResourceAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceAssociationResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sRESOURCE-TYPE%[2]sRESOURCE", id, resourceAssociationResourceIDSeparator)
+}
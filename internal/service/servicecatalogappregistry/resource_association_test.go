@@ -0,0 +1,158 @@
+package servicecatalogappregistry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceCatalogAppRegistryResourceAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_resource_association.test"
+	applicationResourceName := "aws_servicecatalogappregistry_application.test"
+	stackResourceName := "aws_cloudformation_stack.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appregistry.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", applicationResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource", stackResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", stackResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", appregistry.ResourceTypeCfnStack),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryResourceAssociation_disappears(t *testing.T) {
+	resourceName := "aws_servicecatalogappregistry_resource_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, appregistry.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicecatalogappregistry.ResourceResourceAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Resource Association ID is set")
+		}
+
+		applicationID, resourceType, resourceName, err := tfservicecatalogappregistry.ResourceAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+		_, err = tfservicecatalogappregistry.FindResourceAssociationByThreePartKey(context.Background(), conn, applicationID, resourceType, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckResourceAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_resource_association" {
+			continue
+		}
+
+		applicationID, resourceType, resourceName, err := tfservicecatalogappregistry.ResourceAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfservicecatalogappregistry.FindResourceAssociationByThreePartKey(context.Background(), conn, applicationID, resourceType, resourceName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Resource Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccResourceAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    AWSTemplateFormatVersion = "2010-09-09"
+
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = "10.1.0.0/16"
+        }
+      }
+    }
+  })
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_servicecatalogappregistry_resource_association" "test" {
+  application_id = aws_servicecatalogappregistry_application.test.id
+  resource       = aws_cloudformation_stack.test.name
+}
+`, rName)
+}
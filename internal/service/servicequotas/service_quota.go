@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicequotas"
@@ -24,6 +25,11 @@ func ResourceServiceQuota() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"adjustable": {
 				Type:     schema.TypeBool,
@@ -77,6 +83,11 @@ func ResourceServiceQuota() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Required: true,
 			},
+			"wait_for_fulfillment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -124,6 +135,12 @@ func resourceServiceQuotaCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		d.Set("request_id", output.RequestedQuota.Id)
+
+		if d.Get("wait_for_fulfillment").(bool) {
+			if _, err := waitRequestedServiceQuotaChangeResolved(conn, aws.StringValue(output.RequestedQuota.Id), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf("error waiting for Service Quota (%s) increase request (%s) to be resolved: %w", d.Id(), aws.StringValue(output.RequestedQuota.Id), err)
+			}
+		}
 	}
 
 	return resourceServiceQuotaRead(d, meta)
@@ -204,6 +221,10 @@ func resourceServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
 func resourceServiceQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ServiceQuotasConn
 
+	if !d.HasChange("value") {
+		return resourceServiceQuotaRead(d, meta)
+	}
+
 	value := d.Get("value").(float64)
 	serviceCode, quotaCode, err := resourceServiceQuotaParseID(d.Id())
 
@@ -229,6 +250,12 @@ func resourceServiceQuotaUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("request_id", output.RequestedQuota.Id)
 
+	if d.Get("wait_for_fulfillment").(bool) {
+		if _, err := waitRequestedServiceQuotaChangeResolved(conn, aws.StringValue(output.RequestedQuota.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Service Quota (%s) increase request (%s) to be resolved: %w", d.Id(), aws.StringValue(output.RequestedQuota.Id), err)
+		}
+	}
+
 	return resourceServiceQuotaRead(d, meta)
 }
 
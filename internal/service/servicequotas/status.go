@@ -0,0 +1,24 @@
+package servicequotas
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusRequestedServiceQuotaChange(conn *servicequotas.ServiceQuotas, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRequestedServiceQuotaChangeByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,202 @@
+package servicequotas
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTemplateCreate,
+		Read:   resourceTemplateRead,
+		Update: resourceTemplateUpdate,
+		Delete: resourceTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_quota": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"quota_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
+				),
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]`), "must begin with alphabetic character"),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
+				),
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region := d.Get("region").(string)
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+	id := TemplateCreateResourceID(region, serviceCode, quotaCode)
+
+	if err := putTemplate(conn, region, serviceCode, quotaCode, d.Get("value").(float64)); err != nil {
+		return fmt.Errorf("error creating Service Quotas Template (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTemplateRead(d, meta)
+}
+
+func resourceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region, serviceCode, quotaCode, err := TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	template, err := FindTemplateByID(conn, region, serviceCode, quotaCode)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Quotas Template (%s): %w", d.Id(), err)
+	}
+
+	d.Set("global_quota", template.GlobalQuota)
+	d.Set("quota_code", template.QuotaCode)
+	d.Set("quota_name", template.QuotaName)
+	d.Set("region", template.AwsRegion)
+	d.Set("service_code", template.ServiceCode)
+	d.Set("service_name", template.ServiceName)
+	d.Set("unit", template.Unit)
+	d.Set("value", template.DesiredValue)
+
+	return nil
+}
+
+func resourceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region, serviceCode, quotaCode, err := TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := putTemplate(conn, region, serviceCode, quotaCode, d.Get("value").(float64)); err != nil {
+		return fmt.Errorf("error updating Service Quotas Template (%s): %w", d.Id(), err)
+	}
+
+	return resourceTemplateRead(d, meta)
+}
+
+func resourceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	region, serviceCode, quotaCode, err := TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template: %s", d.Id())
+	_, err = conn.DeleteServiceQuotaIncreaseRequestFromTemplate(&servicequotas.DeleteServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Quotas Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putTemplate(conn *servicequotas.ServiceQuotas, region, serviceCode, quotaCode string, value float64) error {
+	input := &servicequotas.PutServiceQuotaIncreaseRequestIntoTemplateInput{
+		AwsRegion:    aws.String(region),
+		DesiredValue: aws.Float64(value),
+		QuotaCode:    aws.String(quotaCode),
+		ServiceCode:  aws.String(serviceCode),
+	}
+
+	log.Printf("[DEBUG] Putting Service Quotas Template: %s", input)
+	_, err := conn.PutServiceQuotaIncreaseRequestIntoTemplate(input)
+
+	return err
+}
+
+const templateResourceIDSeparator = "/"
+
+func TemplateCreateResourceID(region, serviceCode, quotaCode string) string {
+	parts := []string{region, serviceCode, quotaCode}
+	id := strings.Join(parts, templateResourceIDSeparator)
+
+	return id
+}
+
+func TemplateParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, templateResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected REGION%[2]sSERVICE-CODE%[2]sQUOTA-CODE", id, templateResourceIDSeparator)
+}
@@ -0,0 +1,94 @@
+package servicequotas
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceTemplateAssociation associates the organization's Service Quotas template with the organization.
+// Quota increase requests in the template are then submitted automatically for every new account.
+// There is a single association per organization, so the resource ID is the management account ID.
+func ResourceTemplateAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTemplateAssociationCreate,
+		Read:   resourceTemplateAssociationRead,
+		Delete: resourceTemplateAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTemplateAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	_, err := conn.AssociateServiceQuotaTemplate(&servicequotas.AssociateServiceQuotaTemplateInput{})
+
+	if err != nil {
+		return fmt.Errorf("error associating Service Quotas Template: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return resourceTemplateAssociationRead(d, meta)
+}
+
+func resourceTemplateAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	status, err := FindTemplateAssociation(conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Quotas Template Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Quotas Template Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("status", status)
+
+	return nil
+}
+
+func resourceTemplateAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	if v, ok := d.GetOk("skip_destroy"); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining Service Quotas Template Association: %s", d.Id())
+		return nil
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Quotas Template: %s", d.Id())
+	_, err := conn.DisassociateServiceQuotaTemplate(&servicequotas.DisassociateServiceQuotaTemplateInput{})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Quotas Template (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
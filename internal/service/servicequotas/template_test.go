@@ -0,0 +1,147 @@
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceQuotasTemplate_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(setQuotaServiceCode, setQuotaQuotaCode, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "quota_code", setQuotaQuotaCode),
+					resource.TestCheckResourceAttr(resourceName, "quota_name", setQuotaQuotaName),
+					resource.TestCheckResourceAttr(resourceName, "service_code", setQuotaServiceCode),
+					resource.TestCheckResourceAttr(resourceName, "value", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateConfig_basic(setQuotaServiceCode, setQuotaQuotaCode, "20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceQuotasTemplate_disappears(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(unsetQuotaServiceCode, unsetQuotaQuotaCode, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicequotas.ResourceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template" {
+			continue
+		}
+
+		region, serviceCode, quotaCode, err := tfservicequotas.TemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfservicequotas.FindTemplateByID(conn, region, serviceCode, quotaCode)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTemplateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Quotas Template ID is set")
+		}
+
+		region, serviceCode, quotaCode, err := tfservicequotas.TemplateParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceQuotasConn
+
+		_, err = tfservicequotas.FindTemplateByID(conn, region, serviceCode, quotaCode)
+
+		return err
+	}
+}
+
+func testAccTemplateConfig_basic(serviceCode, quotaCode, value string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_servicequotas_template" "test" {
+  region       = data.aws_region.current.name
+  service_code = %[1]q
+  quota_code   = %[2]q
+  value        = %[3]s
+}
+`, serviceCode, quotaCode, value)
+}
@@ -0,0 +1,49 @@
+package servicequotas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitRequestedServiceQuotaChangeResolved(conn *servicequotas.ServiceQuotas, id string, timeout time.Duration) (*servicequotas.RequestedServiceQuotaChange, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicequotas.RequestStatusPending, servicequotas.RequestStatusCaseOpened},
+		Target:  []string{servicequotas.RequestStatusApproved, servicequotas.RequestStatusCaseClosed},
+		Refresh: statusRequestedServiceQuotaChange(conn, id),
+		Timeout: timeout,
+		// Quota increase requests are reviewed on the order of minutes to days.
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	output, ok := outputRaw.(*servicequotas.RequestedServiceQuotaChange)
+
+	if !ok {
+		return nil, err
+	}
+
+	if err != nil {
+		return output, err
+	}
+
+	// A support case can be closed without the increase being granted,
+	// so confirm that the quota reached the requested value.
+	if aws.StringValue(output.Status) == servicequotas.RequestStatusCaseClosed {
+		quota, err := findServiceQuotaByID(conn, aws.StringValue(output.ServiceCode), aws.StringValue(output.QuotaCode))
+
+		if err != nil {
+			return output, fmt.Errorf("reading Service Quota after request case closed: %w", err)
+		}
+
+		if aws.Float64Value(quota.Value) < aws.Float64Value(output.DesiredValue) {
+			return output, fmt.Errorf("request case closed without granting the increase: quota value is %g, requested %g", aws.Float64Value(quota.Value), aws.Float64Value(output.DesiredValue))
+		}
+	}
+
+	return output, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -52,6 +53,11 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instance_id": {
 				Type:       schema.TypeString,
 				ForceNew:   true,
@@ -114,6 +120,44 @@ func ResourceAssociation() *schema.Resource {
 					},
 				},
 			},
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"targets": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -170,6 +214,10 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("instance_id"); ok {
 		associationInput.InstanceId = aws.String(v.(string))
 	}
@@ -190,6 +238,10 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.Targets = expandTargets(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("output_location"); ok {
 		associationInput.OutputLocation = expandAssociationOutputLocation(v.([]interface{}))
 	}
@@ -257,6 +309,7 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", arn)
 	d.Set("apply_only_at_cron_interval", association.ApplyOnlyAtCronInterval)
 	d.Set("association_name", association.AssociationName)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
 	d.Set("instance_id", association.InstanceId)
 	d.Set("name", association.Name)
 	d.Set("association_id", association.AssociationId)
@@ -275,6 +328,10 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting targets error: %w", err)
 	}
 
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return fmt.Errorf("Error setting target_locations error: %w", err)
+	}
+
 	if err := d.Set("output_location", flattenAssociationOutputLocation(association.OutputLocation)); err != nil {
 		return fmt.Errorf("Error setting output_location error: %w", err)
 	}
@@ -291,15 +348,20 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 		AssociationId: aws.String(d.Id()),
 	}
 
-	if v, ok := d.GetOk("apply_only_at_cron_interval"); ok {
-		associationInput.ApplyOnlyAtCronInterval = aws.Bool(v.(bool))
-	}
-
 	// AWS creates a new version every time the association is updated, so everything should be passed in the update.
+	// apply_only_at_cron_interval is always sent so that it can be reverted to false.
+	associationInput.ApplyOnlyAtCronInterval = aws.Bool(d.Get("apply_only_at_cron_interval").(bool))
+
 	if v, ok := d.GetOk("association_name"); ok {
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	} else if d.HasChange("calendar_names") {
+		associationInput.CalendarNames = []*string{}
+	}
+
 	if v, ok := d.GetOk("document_version"); ok {
 		associationInput.DocumentVersion = aws.String(v.(string))
 	}
@@ -316,6 +378,10 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("output_location"); ok {
 		associationInput.OutputLocation = expandAssociationOutputLocation(v.([]interface{}))
 	}
@@ -129,6 +129,81 @@ func TestAccSSMAssociation_applyOnlyAtCronInterval(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_calendarNames(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_calendarNames(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_basicApplyOnlyAtCronInterval(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetLocations(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_locations.0.accounts.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_locations.0.execution_role_name", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_locations.0.regions.*", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_targetLocations(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_withTargets(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_association.test"
@@ -680,6 +755,105 @@ resource "aws_ssm_association" "test" {
 `, rName, applyOnlyAtCronInterval)
 }
 
+func testAccAssociationConfig_calendarNames(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<CAL
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+CAL
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name                = aws_ssm_document.test.name
+  schedule_expression = "cron(0 16 ? * TUE *)"
+  calendar_names      = [aws_ssm_document.calendar.arn]
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName)
+}
+
+func testAccAssociationConfig_targetLocations(rName, rate string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "ssm.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-RestartEC2Instance"
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+
+  target_locations {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    regions                         = [data.aws_region.current.name]
+    execution_role_name             = aws_iam_role.test.name
+    target_location_max_concurrency = %[2]q
+    target_location_max_errors      = %[2]q
+  }
+}
+`, rName, rate)
+}
+
 func testAccAssociationConfig_basicAutomationTargetParamName(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
 resource "aws_iam_instance_profile" "ssm_profile" {
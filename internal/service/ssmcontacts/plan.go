@@ -0,0 +1,342 @@
+package ssmcontacts
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePlanCreate,
+		ReadWithoutTimeout:   resourcePlanRead,
+		UpdateWithoutTimeout: resourcePlanUpdate,
+		DeleteWithoutTimeout: resourcePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			// Stages and their targets are lists, not sets: engagement order is significant.
+			"stage": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 30),
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_channel_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"retry_interval_in_minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 60),
+												},
+											},
+										},
+									},
+									"contact_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"is_essential": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: customizeDiffPlanTargets,
+	}
+}
+
+func resourcePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	contactID := d.Get("contact_id").(string)
+	input := &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(contactID),
+		Plan: &ssmcontacts.Plan{
+			Stages: expandStages(d.Get("stage").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Plan: %s", input)
+	_, err := conn.UpdateContactWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SSM Contacts Plan (%s): %s", contactID, err)
+	}
+
+	d.SetId(contactID)
+
+	return resourcePlanRead(ctx, d, meta)
+}
+
+func resourcePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	plan, err := FindPlanByContactID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SSM Contacts Plan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("contact_id", d.Id())
+
+	if err := d.Set("stage", flattenStages(plan.Stages)); err != nil {
+		return diag.Errorf("setting stage: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChange("stage") {
+		input := &ssmcontacts.UpdateContactInput{
+			ContactId: aws.String(d.Id()),
+			Plan: &ssmcontacts.Plan{
+				Stages: expandStages(d.Get("stage").([]interface{})),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Plan: %s", input)
+		_, err := conn.UpdateContactWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SSM Contacts Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePlanRead(ctx, d, meta)
+}
+
+func resourcePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Plan: %s", d.Id())
+	_, err := conn.UpdateContactWithContext(ctx, &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(d.Id()),
+		Plan: &ssmcontacts.Plan{
+			Stages: []*ssmcontacts.Stage{},
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SSM Contacts Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// customizeDiffPlanTargets ensures that every target names exactly one of a contact channel or a contact.
+// The API only reports this at apply time.
+func customizeDiffPlanTargets(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, tfMapRaw := range d.Get("stage").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for j, tfMapRaw := range tfMap["target"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				return fmt.Errorf("stage.%d.target.%d: exactly one of channel_target_info or contact_target_info must be configured", i, j)
+			}
+
+			channel := len(tfMap["channel_target_info"].([]interface{})) > 0
+			contact := len(tfMap["contact_target_info"].([]interface{})) > 0
+
+			if channel == contact {
+				return fmt.Errorf("stage.%d.target.%d: exactly one of channel_target_info or contact_target_info must be configured", i, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandStages(tfList []interface{}) []*ssmcontacts.Stage {
+	apiObjects := make([]*ssmcontacts.Stage, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Stage{
+			DurationInMinutes: aws.Int64(int64(tfMap["duration_in_minutes"].(int))),
+			Targets:           expandTargets(tfMap["target"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTargets(tfList []interface{}) []*ssmcontacts.Target {
+	apiObjects := make([]*ssmcontacts.Target, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Target{}
+
+		if v, ok := tfMap["channel_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ChannelTargetInfo = expandChannelTargetInfo(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["contact_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContactTargetInfo = expandContactTargetInfo(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandChannelTargetInfo(tfMap map[string]interface{}) *ssmcontacts.ChannelTargetInfo {
+	apiObject := &ssmcontacts.ChannelTargetInfo{}
+
+	if v, ok := tfMap["contact_channel_id"].(string); ok && v != "" {
+		apiObject.ContactChannelId = aws.String(v)
+	}
+
+	if v, ok := tfMap["retry_interval_in_minutes"].(int); ok && v != 0 {
+		apiObject.RetryIntervalInMinutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContactTargetInfo(tfMap map[string]interface{}) *ssmcontacts.ContactTargetInfo {
+	apiObject := &ssmcontacts.ContactTargetInfo{}
+
+	if v, ok := tfMap["contact_id"].(string); ok && v != "" {
+		apiObject.ContactId = aws.String(v)
+	}
+
+	if v, ok := tfMap["is_essential"].(bool); ok {
+		apiObject.IsEssential = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenStages(apiObjects []*ssmcontacts.Stage) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"duration_in_minutes": aws.Int64Value(apiObject.DurationInMinutes),
+			"target":              flattenTargets(apiObject.Targets),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTargets(apiObjects []*ssmcontacts.Target) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ChannelTargetInfo; v != nil {
+			tfMap["channel_target_info"] = []interface{}{map[string]interface{}{
+				"contact_channel_id":        aws.StringValue(v.ContactChannelId),
+				"retry_interval_in_minutes": aws.Int64Value(v.RetryIntervalInMinutes),
+			}}
+		}
+
+		if v := apiObject.ContactTargetInfo; v != nil {
+			tfMap["contact_target_info"] = []interface{}{map[string]interface{}{
+				"contact_id":   aws.StringValue(v.ContactId),
+				"is_essential": aws.BoolValue(v.IsEssential),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
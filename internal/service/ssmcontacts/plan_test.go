@@ -0,0 +1,200 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Incident Manager contacts require a replication set, which cannot yet be managed by the provider.
+// The tests therefore use pre-existing escalation and personal contacts.
+const (
+	envVarEscalationContactARN = "AWS_SSMCONTACTS_ESCALATION_CONTACT_ARN"
+	envVarPersonalContactARN   = "AWS_SSMCONTACTS_PERSONAL_CONTACT_ARN"
+)
+
+func TestAccSSMContactsPlan_basic(t *testing.T) {
+	escalationContactARN, personalContactARN := testAccPlanContactsFromEnv(t)
+	var v ssmcontacts.Plan
+	resourceName := "aws_ssmcontacts_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ssmcontacts.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(escalationContactARN, personalContactARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "contact_id", escalationContactARN),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.0.contact_id", personalContactARN),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.0.is_essential", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMContactsPlan_stageOrder(t *testing.T) {
+	escalationContactARN, personalContactARN := testAccPlanContactsFromEnv(t)
+	var v ssmcontacts.Plan
+	resourceName := "aws_ssmcontacts_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ssmcontacts.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_stages(escalationContactARN, personalContactARN, 5, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.duration_in_minutes", "10"),
+				),
+			},
+			{
+				Config: testAccPlanConfig_stages(escalationContactARN, personalContactARN, 10, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.duration_in_minutes", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPlanContactsFromEnv(t *testing.T) (string, string) {
+	escalationContactARN := os.Getenv(envVarEscalationContactARN)
+	if escalationContactARN == "" {
+		t.Skipf("Environment variable %s is not set", envVarEscalationContactARN)
+	}
+
+	personalContactARN := os.Getenv(envVarPersonalContactARN)
+	if personalContactARN == "" {
+		t.Skipf("Environment variable %s is not set", envVarPersonalContactARN)
+	}
+
+	return escalationContactARN, personalContactARN
+}
+
+func testAccCheckPlanDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_plan" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindPlanByContactID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPlanExists(n string, v *ssmcontacts.Plan) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		output, err := tfssmcontacts.FindPlanByContactID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPlanConfig_basic(escalationContactARN, personalContactARN string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_plan" "test" {
+  contact_id = %[1]q
+
+  stage {
+    duration_in_minutes = 5
+
+    target {
+      contact_target_info {
+        contact_id   = %[2]q
+        is_essential = false
+      }
+    }
+  }
+}
+`, escalationContactARN, personalContactARN)
+}
+
+func testAccPlanConfig_stages(escalationContactARN, personalContactARN string, duration1, duration2 int) string {
+	return fmt.Sprintf(`
+resource "aws_ssmcontacts_plan" "test" {
+  contact_id = %[1]q
+
+  stage {
+    duration_in_minutes = %[3]d
+
+    target {
+      contact_target_info {
+        contact_id   = %[2]q
+        is_essential = false
+      }
+    }
+  }
+
+  stage {
+    duration_in_minutes = %[4]d
+
+    target {
+      contact_target_info {
+        contact_id   = %[2]q
+        is_essential = true
+      }
+    }
+  }
+}
+`, escalationContactARN, personalContactARN, duration1, duration2)
+}
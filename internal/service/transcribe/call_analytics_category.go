@@ -0,0 +1,533 @@
+package transcribe
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCallAnalyticsCategory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCallAnalyticsCategoryCreate,
+		ReadWithoutTimeout:   resourceCallAnalyticsCategoryRead,
+		UpdateWithoutTimeout: resourceCallAnalyticsCategoryUpdate,
+		DeleteWithoutTimeout: resourceCallAnalyticsCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"category_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interruption_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"participant_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+									},
+									"relative_time_range": relativeTimeRangeSchema(),
+									"threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 14400000),
+									},
+								},
+							},
+						},
+						"non_talk_time_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"relative_time_range": relativeTimeRangeSchema(),
+									"threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 14400000),
+									},
+								},
+							},
+						},
+						"sentiment_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"participant_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+									},
+									"relative_time_range": relativeTimeRangeSchema(),
+									"sentiments": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(transcribeservice.SentimentValue_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"transcript_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"absolute_time_range": absoluteTimeRangeSchema(),
+									"negate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"participant_role": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+									},
+									"relative_time_range": relativeTimeRangeSchema(),
+									"targets": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 2000),
+										},
+									},
+									"transcript_filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(transcribeservice.TranscriptFilterType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func absoluteTimeRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 14400000),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 14400000),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 14400000),
+				},
+				"start_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 14400000),
+				},
+			},
+		},
+	}
+}
+
+func relativeTimeRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"start_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func resourceCallAnalyticsCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	name := d.Get("category_name").(string)
+	input := &transcribeservice.CreateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+		Rules:        expandRules(d.Get("rule").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Call Analytics Category: %s", input)
+	_, err := conn.CreateCallAnalyticsCategoryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Transcribe Call Analytics Category (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceCallAnalyticsCategoryRead(ctx, d, meta)
+}
+
+func resourceCallAnalyticsCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	output, err := FindCallAnalyticsCategoryByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Call Analytics Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Transcribe Call Analytics Category (%s): %s", d.Id(), err)
+	}
+
+	d.Set("category_name", output.CategoryName)
+	if output.CreateTime != nil {
+		d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	} else {
+		d.Set("create_time", nil)
+	}
+	if output.LastUpdateTime != nil {
+		d.Set("last_update_time", aws.TimeValue(output.LastUpdateTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_update_time", nil)
+	}
+	if err := d.Set("rule", flattenRules(output.Rules)); err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCallAnalyticsCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	input := &transcribeservice.UpdateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+		Rules:        expandRules(d.Get("rule").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Transcribe Call Analytics Category: %s", input)
+	_, err := conn.UpdateCallAnalyticsCategoryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Transcribe Call Analytics Category (%s): %s", d.Id(), err)
+	}
+
+	return resourceCallAnalyticsCategoryRead(ctx, d, meta)
+}
+
+func resourceCallAnalyticsCategoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Call Analytics Category: %s", d.Id())
+	_, err := conn.DeleteCallAnalyticsCategoryWithContext(ctx, &transcribeservice.DeleteCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Transcribe Call Analytics Category (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRules(tfList []interface{}) []*transcribeservice.Rule {
+	var apiObjects []*transcribeservice.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &transcribeservice.Rule{}
+
+		if v, ok := tfMap["interruption_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.InterruptionFilter = &transcribeservice.InterruptionFilter{
+				AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:            aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				apiObject.InterruptionFilter.ParticipantRole = aws.String(v)
+			}
+
+			if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+				apiObject.InterruptionFilter.Threshold = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["non_talk_time_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.NonTalkTimeFilter = &transcribeservice.NonTalkTimeFilter{
+				AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:            aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+			}
+
+			if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+				apiObject.NonTalkTimeFilter.Threshold = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["sentiment_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SentimentFilter = &transcribeservice.SentimentFilter{
+				AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:            aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+				Sentiments:        flex.ExpandStringSet(tfMap["sentiments"].(*schema.Set)),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				apiObject.SentimentFilter.ParticipantRole = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["transcript_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.TranscriptFilter = &transcribeservice.TranscriptFilter{
+				AbsoluteTimeRange:    expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+				Negate:               aws.Bool(tfMap["negate"].(bool)),
+				RelativeTimeRange:    expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+				Targets:              flex.ExpandStringList(tfMap["targets"].([]interface{})),
+				TranscriptFilterType: aws.String(tfMap["transcript_filter_type"].(string)),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				apiObject.TranscriptFilter.ParticipantRole = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAbsoluteTimeRange(tfList []interface{}) *transcribeservice.AbsoluteTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &transcribeservice.AbsoluteTimeRange{}
+
+	if v, ok := tfMap["end_time"].(int); ok && v != 0 {
+		apiObject.EndTime = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v != 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v != 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_time"].(int); ok && v != 0 {
+		apiObject.StartTime = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandRelativeTimeRange(tfList []interface{}) *transcribeservice.RelativeTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &transcribeservice.RelativeTimeRange{}
+
+	if v, ok := tfMap["end_percentage"].(int); ok && v != 0 {
+		apiObject.EndPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v != 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v != 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_percentage"].(int); ok && v != 0 {
+		apiObject.StartPercentage = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRules(apiObjects []*transcribeservice.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.InterruptionFilter; v != nil {
+			tfMap["interruption_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":              aws.BoolValue(v.Negate),
+				"participant_role":    aws.StringValue(v.ParticipantRole),
+				"relative_time_range": flattenRelativeTimeRange(v.RelativeTimeRange),
+				"threshold":           aws.Int64Value(v.Threshold),
+			}}
+		}
+
+		if v := apiObject.NonTalkTimeFilter; v != nil {
+			tfMap["non_talk_time_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":              aws.BoolValue(v.Negate),
+				"relative_time_range": flattenRelativeTimeRange(v.RelativeTimeRange),
+				"threshold":           aws.Int64Value(v.Threshold),
+			}}
+		}
+
+		if v := apiObject.SentimentFilter; v != nil {
+			tfMap["sentiment_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":              aws.BoolValue(v.Negate),
+				"participant_role":    aws.StringValue(v.ParticipantRole),
+				"relative_time_range": flattenRelativeTimeRange(v.RelativeTimeRange),
+				"sentiments":          aws.StringValueSlice(v.Sentiments),
+			}}
+		}
+
+		if v := apiObject.TranscriptFilter; v != nil {
+			tfMap["transcript_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range":    flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":                 aws.BoolValue(v.Negate),
+				"participant_role":       aws.StringValue(v.ParticipantRole),
+				"relative_time_range":    flattenRelativeTimeRange(v.RelativeTimeRange),
+				"targets":                aws.StringValueSlice(v.Targets),
+				"transcript_filter_type": aws.StringValue(v.TranscriptFilterType),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAbsoluteTimeRange(apiObject *transcribeservice.AbsoluteTimeRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"end_time":   aws.Int64Value(apiObject.EndTime),
+		"first":      aws.Int64Value(apiObject.First),
+		"last":       aws.Int64Value(apiObject.Last),
+		"start_time": aws.Int64Value(apiObject.StartTime),
+	}}
+}
+
+func flattenRelativeTimeRange(apiObject *transcribeservice.RelativeTimeRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"end_percentage":   aws.Int64Value(apiObject.EndPercentage),
+		"first":            aws.Int64Value(apiObject.First),
+		"last":             aws.Int64Value(apiObject.Last),
+		"start_percentage": aws.Int64Value(apiObject.StartPercentage),
+	}}
+}
@@ -0,0 +1,194 @@
+package transcribe_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeCallAnalyticsCategory_basic(t *testing.T) {
+	var v transcribeservice.CategoryProperties
+	resourceName := "aws_transcribe_call_analytics_category.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCallAnalyticsCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_update_time"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.participant_role", transcribeservice.ParticipantRoleCustomer),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.targets.0", "cancel my subscription"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.transcript_filter_type", transcribeservice.TranscriptFilterTypeExact),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCallAnalyticsCategoryConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.0.sentiments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.0.sentiment_filter.0.sentiments.*", transcribeservice.SentimentValueNegative),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.0.relative_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.sentiment_filter.0.relative_time_range.0.last", "20"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.interruption_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.interruption_filter.0.threshold", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.non_talk_time_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.non_talk_time_filter.0.absolute_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.non_talk_time_filter.0.absolute_time_range.0.first", "60000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.non_talk_time_filter.0.negate", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_disappears(t *testing.T) {
+	var v transcribeservice.CategoryProperties
+	resourceName := "aws_transcribe_call_analytics_category.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCallAnalyticsCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceCallAnalyticsCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCallAnalyticsCategoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_call_analytics_category" {
+			continue
+		}
+
+		_, err := tftranscribe.FindCallAnalyticsCategoryByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Call Analytics Category %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckCallAnalyticsCategoryExists(n string, v *transcribeservice.CategoryProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Call Analytics Category ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindCallAnalyticsCategoryByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCallAnalyticsCategoryConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+
+  rule {
+    transcript_filter {
+      participant_role       = "CUSTOMER"
+      targets                = ["cancel my subscription"]
+      transcript_filter_type = "EXACT"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCallAnalyticsCategoryConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+
+  rule {
+    sentiment_filter {
+      participant_role = "CUSTOMER"
+      sentiments       = ["NEGATIVE"]
+
+      relative_time_range {
+        last = 20
+      }
+    }
+  }
+
+  rule {
+    interruption_filter {
+      participant_role = "AGENT"
+      threshold        = 10000
+    }
+  }
+
+  rule {
+    non_talk_time_filter {
+      negate    = true
+      threshold = 5000
+
+      absolute_time_range {
+        first = 60000
+      }
+    }
+  }
+}
+`, rName)
+}
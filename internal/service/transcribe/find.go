@@ -0,0 +1,36 @@
+package transcribe
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCallAnalyticsCategoryByName(ctx context.Context, conn *transcribeservice.TranscribeService, name string) (*transcribeservice.CategoryProperties, error) {
+	input := &transcribeservice.GetCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+	}
+
+	output, err := conn.GetCallAnalyticsCategoryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CategoryProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CategoryProperties, nil
+}
@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package transcribe
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_transcribe_call_analytics_category", &resource.Sweeper{
+		Name: "aws_transcribe_call_analytics_category",
+		F:    sweepCallAnalyticsCategories,
+	})
+}
+
+func sweepCallAnalyticsCategories(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranscribeConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCallAnalyticsCategoriesPages(&transcribeservice.ListCallAnalyticsCategoriesInput{}, func(page *transcribeservice.ListCallAnalyticsCategoriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Categories {
+			r := ResourceCallAnalyticsCategory()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CategoryName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transcribe Call Analytics Category sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transcribe Call Analytics Categories (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transcribe Call Analytics Categories (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package translate
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindParallelDataByName(ctx context.Context, conn *translate.Translate, name string) (*translate.ParallelDataProperties, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelDataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ParallelDataProperties, nil
+}
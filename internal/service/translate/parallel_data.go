@@ -0,0 +1,301 @@
+package translate
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceParallelData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParallelDataCreate,
+		ReadWithoutTimeout:   resourceParallelDataRead,
+		UpdateWithoutTimeout: resourceParallelDataUpdate,
+		DeleteWithoutTimeout: resourceParallelDataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 400),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(translate.EncryptionKeyType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^([A-Za-z0-9-]_?)+$`), "must contain only alphanumeric characters, hyphens and single underscores"),
+				),
+			},
+			"parallel_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.ParallelDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[sS]3://`), "must be an S3 URL"),
+						},
+					},
+				},
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_language_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceParallelDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	name := d.Get("name").(string)
+	input := &translate.CreateParallelDataInput{
+		Name:               aws.String(name),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Translate Parallel Data: %s", input)
+	_, err := conn.CreateParallelDataWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Translate Parallel Data (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitParallelDataCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Translate Parallel Data (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceParallelDataRead(ctx, d, meta)
+}
+
+func resourceParallelDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	output, err := FindParallelDataByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Parallel Data (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if output.EncryptionKey != nil {
+		if err := d.Set("encryption_key", []interface{}{flattenEncryptionKey(output.EncryptionKey)}); err != nil {
+			return diag.Errorf("setting encryption_key: %s", err)
+		}
+	} else {
+		d.Set("encryption_key", nil)
+	}
+	d.Set("name", output.Name)
+	if output.ParallelDataConfig != nil {
+		if err := d.Set("parallel_data_config", []interface{}{flattenParallelDataConfig(output.ParallelDataConfig)}); err != nil {
+			return diag.Errorf("setting parallel_data_config: %s", err)
+		}
+	} else {
+		d.Set("parallel_data_config", nil)
+	}
+	d.Set("source_language_code", output.SourceLanguageCode)
+	d.Set("status", output.Status)
+	d.Set("target_language_codes", aws.StringValueSlice(output.TargetLanguageCodes))
+
+	return nil
+}
+
+func resourceParallelDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	input := &translate.UpdateParallelDataInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Name:               aws.String(d.Id()),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})[0].(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Translate Parallel Data: %s", input)
+	_, err := conn.UpdateParallelDataWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for Translate Parallel Data (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceParallelDataRead(ctx, d, meta)
+}
+
+func resourceParallelDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranslateConn
+
+	log.Printf("[DEBUG] Deleting Translate Parallel Data: %s", d.Id())
+	_, err := conn.DeleteParallelDataWithContext(ctx, &translate.DeleteParallelDataInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Translate Parallel Data (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEncryptionKey(tfMap map[string]interface{}) *translate.EncryptionKey {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &translate.EncryptionKey{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandParallelDataConfig(tfMap map[string]interface{}) *translate.ParallelDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &translate.ParallelDataConfig{}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncryptionKey(apiObject *translate.EncryptionKey) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Id; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenParallelDataConfig(apiObject *translate.ParallelDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Format; v != nil {
+		tfMap["format"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.S3Uri; v != nil {
+		tfMap["s3_uri"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
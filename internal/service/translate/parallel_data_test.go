@@ -0,0 +1,227 @@
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, translate.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParallelDataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "hello,hola"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "translate", fmt.Sprintf("parallel-data/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", translate.ParallelDataFormatCsv),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%[1]s/%[1]s.csv", rName)),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "status", translate.ParallelDataStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "es"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParallelDataConfig_description(rName, "hello,buenos dias", "Terraform acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "status", translate.ParallelDataStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, translate.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParallelDataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "hello,hola"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranslate.ResourceParallelData(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_encryptionKey(t *testing.T) {
+	var v translate.ParallelDataProperties
+	resourceName := "aws_translate_parallel_data.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, translate.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckParallelDataDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_encryptionKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key.0.id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.0.type", translate.EncryptionKeyTypeKms),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_translate_parallel_data" {
+			continue
+		}
+
+		_, err := tftranslate.FindParallelDataByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckParallelDataExists(n string, v *translate.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Translate Parallel Data ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn
+
+		output, err := tftranslate.FindParallelDataByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParallelDataConfig_base(rName, row string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s.csv"
+  content = "en,es\n%[2]s\n"
+}
+`, rName, row)
+}
+
+func testAccParallelDataConfig_basic(rName, row string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, row), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName))
+}
+
+func testAccParallelDataConfig_description(rName, row, description string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, row), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName, description))
+}
+
+func testAccParallelDataConfig_encryptionKey(rName string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName, "hello,hola"), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  encryption_key {
+    id   = aws_kms_key.test.arn
+    type = "KMS"
+  }
+}
+`, rName))
+}
@@ -0,0 +1,44 @@
+package translate
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusParallelData(ctx context.Context, conn *translate.Translate, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusParallelDataLatestUpdateAttempt returns the status of the most recent update.
+// The parallel data remains ACTIVE, using its previous data, while an update is in progress.
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Translate, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LatestUpdateAttemptStatus), nil
+	}
+}
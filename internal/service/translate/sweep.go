@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package translate
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_translate_parallel_data", &resource.Sweeper{
+		Name: "aws_translate_parallel_data",
+		F:    sweepParallelData,
+	})
+}
+
+func sweepParallelData(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranslateConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListParallelDataPages(&translate.ListParallelDataInput{}, func(page *translate.ListParallelDataOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ParallelDataPropertiesList {
+			r := ResourceParallelData()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Translate Parallel Data sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Translate Parallel Data (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Translate Parallel Data (%s): %w", region, err)
+	}
+
+	return nil
+}
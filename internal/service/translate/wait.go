@@ -0,0 +1,71 @@
+package translate
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusCreating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		if status := aws.StringValue(output.Status); status == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusUpdating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		if status := aws.StringValue(output.LatestUpdateAttemptStatus); status == translate.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusDeleting},
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -74,10 +74,14 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/keyspaces"
@@ -100,10 +104,13 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -114,6 +121,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
@@ -123,7 +131,9 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"